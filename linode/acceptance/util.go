@@ -32,6 +32,8 @@ const (
 	optInTestsEnvVar         = "ACC_OPT_IN_TESTS"
 	SkipInstanceReadyPollKey = "skip_instance_ready_poll"
 
+	objTestEndpointEnvVar = "LINODE_OBJ_TEST_ENDPOINT"
+
 	runLongTestsEnvVar  = "RUN_LONG_TEST"
 	skipLongTestMessage = "This test has been marked as a long-running test and is skipped by default. " +
 		"If you would like to run this test, please set the RUN_LONG_TEST environment variable to true."
@@ -137,7 +139,10 @@ func init() {
 		log.Fatalf("failed to parse templates: %v", err)
 	}
 
-	initTestImages()
+	// Tests against a local S3-compatible server run without the Linode API
+	if !localOBJTestsOnly() {
+		initTestImages()
+	}
 }
 
 func TestProvider(t *testing.T) {
@@ -182,6 +187,32 @@ func LongRunningTest(t *testing.T) {
 	}
 }
 
+// localOBJTestsOnly returns whether only a local S3-compatible endpoint
+// is configured for the tests, without a Linode token.
+func localOBJTestsOnly() bool {
+	return os.Getenv("LINODE_TOKEN") == "" && os.Getenv(objTestEndpointEnvVar) != ""
+}
+
+// OBJTestEndpoint returns the local S3-compatible endpoint to run object storage
+// tests against, skipping the test if it isn't configured. These tests don't
+// require a Linode token.
+func OBJTestEndpoint(t *testing.T) string {
+	t.Helper()
+
+	endpoint := os.Getenv(objTestEndpointEnvVar)
+	if endpoint == "" {
+		t.Skipf("skipping local object storage test; set %q to an S3-compatible endpoint to run", objTestEndpointEnvVar)
+	}
+
+	for _, key := range []string{"LINODE_OBJ_ACCESS_KEY", "LINODE_OBJ_SECRET_KEY"} {
+		if os.Getenv(key) == "" {
+			t.Fatalf("%s must be set for local object storage tests", key)
+		}
+	}
+
+	return endpoint
+}
+
 func GetSSHClient(t *testing.T, user, addr string) (client *ssh.Client) {
 	t.Helper()
 
@@ -635,6 +666,16 @@ func GetRandomRegionWithCaps(capabilities []string, filters ...RegionFilterFunc)
 }
 
 // GetRandomOBJCluster gets a random Object Storage cluster.
+// GetOBJTestCluster returns a random object storage cluster, or a placeholder
+// when only a local S3-compatible endpoint is configured without a Linode token.
+func GetOBJTestCluster() (string, error) {
+	if localOBJTestsOnly() {
+		return "local-1", nil
+	}
+
+	return GetRandomOBJCluster()
+}
+
 func GetRandomOBJCluster() (string, error) {
 	client, err := GetTestClient()
 	if err != nil {
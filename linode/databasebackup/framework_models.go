@@ -0,0 +1,34 @@
+package databasebackup
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type ResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	DatabaseID   types.Int64    `tfsdk:"database_id"`
+	DatabaseType types.String   `tfsdk:"database_type"`
+	Label        types.String   `tfsdk:"label"`
+	Target       types.String   `tfsdk:"target"`
+	Type         types.String   `tfsdk:"type"`
+	Created      types.String   `tfsdk:"created"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (data *ResourceModel) FlattenBackup(backup *backup, preserveKnown bool) {
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(backup.ID), preserveKnown)
+	data.Label = helper.KeepOrUpdateString(data.Label, backup.Label, preserveKnown)
+	data.Type = helper.KeepOrUpdateString(data.Type, backup.Type, preserveKnown)
+
+	var created string
+	if backup.Created != nil {
+		created = backup.Created.Format(time.RFC3339)
+	}
+
+	data.Created = helper.KeepOrUpdateString(data.Created, created, preserveKnown)
+}
@@ -0,0 +1,106 @@
+package databasebackup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// backup is a backup of a database of either engine.
+type backup struct {
+	ID      int
+	Label   string
+	Type    string
+	Created *time.Time
+}
+
+// createBackup creates a backup of the database and waits for it to be available.
+func createBackup(
+	ctx context.Context,
+	client *linodego.Client,
+	engine string,
+	dbID int,
+	label, target string,
+	timeout time.Duration,
+) (*backup, error) {
+	timeoutSeconds, err := helper.SafeFloat64ToInt(timeout.Seconds())
+	if err != nil {
+		return nil, err
+	}
+
+	switch engine {
+	case "mysql":
+		if err := client.CreateMySQLDatabaseBackup(ctx, dbID, linodego.MySQLBackupCreateOptions{
+			Label:  label,
+			Target: linodego.MySQLDatabaseTarget(target),
+		}); err != nil {
+			return nil, err
+		}
+
+		b, err := client.WaitForMySQLDatabaseBackup(ctx, dbID, label, timeoutSeconds)
+		if err != nil {
+			return nil, err
+		}
+		return &backup{ID: b.ID, Label: b.Label, Type: b.Type, Created: b.Created}, nil
+	case "postgresql":
+		if err := client.CreatePostgresDatabaseBackup(ctx, dbID, linodego.PostgresBackupCreateOptions{
+			Label:  label,
+			Target: linodego.PostgresDatabaseTarget(target),
+		}); err != nil {
+			return nil, err
+		}
+
+		b, err := client.WaitForPostgresDatabaseBackup(ctx, dbID, label, timeoutSeconds)
+		if err != nil {
+			return nil, err
+		}
+		return &backup{ID: b.ID, Label: b.Label, Type: b.Type, Created: b.Created}, nil
+	}
+
+	return nil, fmt.Errorf("invalid database type: %s", engine)
+}
+
+func getBackup(
+	ctx context.Context,
+	client *linodego.Client,
+	engine string,
+	dbID, id int,
+) (*backup, error) {
+	switch engine {
+	case "mysql":
+		b, err := client.GetMySQLDatabaseBackup(ctx, dbID, id)
+		if err != nil {
+			return nil, err
+		}
+		return &backup{ID: b.ID, Label: b.Label, Type: b.Type, Created: b.Created}, nil
+	case "postgresql":
+		b, err := client.GetPostgresDatabaseBackup(ctx, dbID, id)
+		if err != nil {
+			return nil, err
+		}
+		return &backup{ID: b.ID, Label: b.Label, Type: b.Type, Created: b.Created}, nil
+	}
+
+	return nil, fmt.Errorf("invalid database type: %s", engine)
+}
+
+// NOTE: This endpoint is not yet supported by linodego.
+func deleteBackup(
+	ctx context.Context,
+	client *linodego.Client,
+	engine string,
+	dbID, id int,
+) error {
+	_, err := helper.DoAPIRequest[struct{}](
+		ctx,
+		client,
+		http.MethodDelete,
+		fmt.Sprintf("databases/%s/instances/%d/backups/%d", engine, dbID, id),
+		nil,
+	)
+	return err
+}
@@ -0,0 +1,61 @@
+//go:build unit
+
+package databasebackup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAndDeleteBackup(t *testing.T) {
+	var deleted []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		path := strings.TrimPrefix(r.URL.Path, "/v4")
+
+		switch {
+		case r.Method == http.MethodDelete && path == "/databases/postgresql/instances/10/backups/20":
+			deleted = append(deleted, path)
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == http.MethodGet && path == "/databases/mysql/instances/10/backups/20":
+			_, _ = w.Write([]byte(`{"id": 20, "label": "snapshot", "type": "snapshot", "created": "2024-03-01T12:30:00"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	ctx := context.Background()
+
+	backup, err := getBackup(ctx, &client, "mysql", 10, 20)
+	require.NoError(t, err)
+
+	data := ResourceModel{Label: types.StringValue("snapshot")}
+	data.FlattenBackup(backup, false)
+
+	assert.Equal(t, types.StringValue("20"), data.ID)
+	assert.Equal(t, types.StringValue("snapshot"), data.Type)
+	assert.Equal(t, types.StringValue("2024-03-01T12:30:00Z"), data.Created)
+
+	_, err = getBackup(ctx, &client, "postgresql", 10, 20)
+	lerr, ok := err.(*linodego.Error)
+	require.True(t, ok, err)
+	assert.Equal(t, http.StatusNotFound, lerr.Code)
+
+	require.NoError(t, deleteBackup(ctx, &client, "postgresql", 10, 20))
+	assert.Equal(t, []string{"/databases/postgresql/instances/10/backups/20"}, deleted)
+}
@@ -0,0 +1,202 @@
+//go:build integration
+
+package databasebackup_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/databasebackup/tmpl"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const resName = "linode_database_backup.foobar"
+
+var (
+	mysqlEngineVersion    string
+	postgresEngineVersion string
+	testRegion            string
+)
+
+func init() {
+	client, err := acceptance.GetTestClient()
+	if err != nil {
+		log.Fatalf("failed to get client: %s", err)
+	}
+
+	v, err := helper.ResolveValidDBEngine(context.Background(), *client, "mysql")
+	if err != nil {
+		log.Fatalf("failed to get db engine version: %s", err)
+	}
+
+	mysqlEngineVersion = v.ID
+
+	v, err = helper.ResolveValidDBEngine(context.Background(), *client, "postgresql")
+	if err != nil {
+		log.Fatalf("failed to get db engine version: %s", err)
+	}
+
+	postgresEngineVersion = v.ID
+
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"Managed Databases"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceDatabaseBackup_MySQL(t *testing.T) {
+	acceptance.LongRunningTest(t)
+	t.Parallel()
+
+	dbName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             checkBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.MySQL(t, dbName, mysqlEngineVersion, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					checkBackupExists,
+					resource.TestCheckResourceAttr(resName, "label", dbName+"-backup"),
+					resource.TestCheckResourceAttr(resName, "target", "primary"),
+					resource.TestCheckResourceAttr(resName, "type", "snapshot"),
+					resource.TestCheckResourceAttrSet(resName, "created"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importResourceStateID,
+			},
+			{
+				Config: tmpl.MySQLRestore(t, dbName, mysqlEngineVersion, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("linode_database_mysql.restored", "status", "active"),
+					resource.TestCheckResourceAttrPair(
+						"linode_database_mysql.restored", "restore_from.0.backup_id", resName, "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceDatabaseBackup_PostgreSQL(t *testing.T) {
+	acceptance.LongRunningTest(t)
+	t.Parallel()
+
+	dbName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             checkBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.PostgreSQL(t, dbName, postgresEngineVersion, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					checkBackupExists,
+					resource.TestCheckResourceAttr(resName, "database_type", "postgresql"),
+					resource.TestCheckResourceAttrSet(resName, "created"),
+				),
+			},
+		},
+	})
+}
+
+func getBackupIDs(rs *terraform.ResourceState) (int, int, error) {
+	dbID, err := strconv.Atoi(rs.Primary.Attributes["database_id"])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Error parsing %v to int", rs.Primary.Attributes["database_id"])
+	}
+
+	id, err := strconv.Atoi(rs.Primary.ID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
+	}
+
+	return dbID, id, nil
+}
+
+func getBackup(client *linodego.Client, rs *terraform.ResourceState) error {
+	dbID, id, err := getBackupIDs(rs)
+	if err != nil {
+		return err
+	}
+
+	switch rs.Primary.Attributes["database_type"] {
+	case "mysql":
+		_, err = client.GetMySQLDatabaseBackup(context.Background(), dbID, id)
+	case "postgresql":
+		_, err = client.GetPostgresDatabaseBackup(context.Background(), dbID, id)
+	}
+
+	return err
+}
+
+func checkBackupExists(s *terraform.State) error {
+	client, err := acceptance.GetTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get client: %s", err)
+	}
+
+	rs, ok := s.RootModule().Resources[resName]
+	if !ok {
+		return fmt.Errorf("resource not found: %s", resName)
+	}
+
+	if err := getBackup(client, rs); err != nil {
+		return fmt.Errorf("error retrieving state of database backup %s: %s", rs.Primary.ID, err)
+	}
+
+	return nil
+}
+
+func checkBackupDestroy(s *terraform.State) error {
+	client, err := acceptance.GetTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_database_backup" {
+			continue
+		}
+
+		err := getBackup(client, rs)
+		if err == nil {
+			return fmt.Errorf("database backup %s still exists", rs.Primary.ID)
+		}
+
+		if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
+			return fmt.Errorf("error requesting database backup %s: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func importResourceStateID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources[resName]
+	if !ok {
+		return "", fmt.Errorf("resource not found: %s", resName)
+	}
+
+	return fmt.Sprintf(
+		"%s,%s,%s",
+		rs.Primary.Attributes["database_id"], rs.Primary.Attributes["database_type"], rs.Primary.ID,
+	), nil
+}
@@ -0,0 +1,40 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Engine string
+	Label  string
+	Region string
+}
+
+func MySQL(t *testing.T, label, engine, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"database_backup_mysql", TemplateData{
+			Engine: engine,
+			Label:  label,
+			Region: region,
+		})
+}
+
+func MySQLRestore(t *testing.T, label, engine, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"database_backup_mysql_restore", TemplateData{
+			Engine: engine,
+			Label:  label,
+			Region: region,
+		})
+}
+
+func PostgreSQL(t *testing.T, label, engine, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"database_backup_postgresql", TemplateData{
+			Engine: engine,
+			Label:  label,
+			Region: region,
+		})
+}
@@ -0,0 +1,70 @@
+package databasecredentialsrotation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	DatabaseID   types.Int64    `tfsdk:"database_id"`
+	DatabaseType types.String   `tfsdk:"database_type"`
+	Triggers     types.Map      `tfsdk:"triggers"`
+	RotateAfter  types.String   `tfsdk:"rotate_after"`
+	RotatedAt    types.String   `tfsdk:"rotated_at"`
+	RootUsername types.String   `tfsdk:"root_username"`
+	RootPassword types.String   `tfsdk:"root_password"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (data *ResourceModel) FlattenCredentials(creds *credentials) {
+	data.RootUsername = types.StringValue(creds.Username)
+	data.RootPassword = types.StringValue(creds.Password)
+}
+
+// getRotateAfter parses rotate_after, which must be a positive duration.
+func (data *ResourceModel) getRotateAfter() (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rotateAfter, err := time.ParseDuration(data.RotateAfter.ValueString())
+	if err == nil && rotateAfter <= 0 {
+		err = fmt.Errorf("must be a positive duration, got %s", data.RotateAfter.ValueString())
+	}
+
+	if err != nil {
+		diags.AddAttributeError(path.Root("rotate_after"), "Invalid Rotation Interval", err.Error())
+	}
+
+	return rotateAfter, diags
+}
+
+// rotationDue returns whether rotate_after has passed since the credentials were
+// last rotated. Credentials of an imported rotation are always due, since when
+// they were last rotated is unknown.
+func (data *ResourceModel) rotationDue(now time.Time) (bool, diag.Diagnostics) {
+	if data.RotateAfter.IsNull() || data.RotateAfter.IsUnknown() {
+		return false, nil
+	}
+
+	rotateAfter, diags := data.getRotateAfter()
+	if diags.HasError() {
+		return false, diags
+	}
+
+	if data.RotatedAt.IsNull() {
+		return true, nil
+	}
+
+	rotatedAt, err := time.Parse(time.RFC3339, data.RotatedAt.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("rotated_at"), "Invalid Rotation Time", err.Error())
+		return false, diags
+	}
+
+	return !now.Before(rotatedAt.Add(rotateAfter)), nil
+}
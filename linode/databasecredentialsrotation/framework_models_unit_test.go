@@ -0,0 +1,58 @@
+//go:build unit
+
+package databasecredentialsrotation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotationDue(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		rotateAfter types.String
+		rotatedAt   types.String
+		expected    bool
+	}{
+		{"no interval", types.StringNull(), types.StringValue("2020-01-01T00:00:00Z"), false},
+		{"unknown interval", types.StringUnknown(), types.StringValue("2020-01-01T00:00:00Z"), false},
+		{"not due", types.StringValue("720h"), types.StringValue("2024-02-15T12:00:00Z"), false},
+		{"due", types.StringValue("720h"), types.StringValue("2024-01-31T12:00:00Z"), true},
+		{"exactly due", types.StringValue("24h"), types.StringValue("2024-02-29T12:00:00Z"), true},
+		{"imported", types.StringValue("720h"), types.StringNull(), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := ResourceModel{
+				RotateAfter: tc.rotateAfter,
+				RotatedAt:   tc.rotatedAt,
+			}
+
+			due, diags := data.rotationDue(now)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tc.expected, due)
+		})
+	}
+}
+
+func TestGetRotateAfter(t *testing.T) {
+	data := ResourceModel{RotateAfter: types.StringValue("1h30m")}
+
+	rotateAfter, diags := data.getRotateAfter()
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, 90*time.Minute, rotateAfter)
+
+	for _, value := range []string{"30", "-1h", "0s", "monthly"} {
+		data.RotateAfter = types.StringValue(value)
+
+		_, diags = data.getRotateAfter()
+		assert.True(t, diags.HasError(), value)
+	}
+}
@@ -0,0 +1,247 @@
+package databasecredentialsrotation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const defaultRotateTimeout = 15 * time.Minute
+
+var (
+	_ resource.ResourceWithModifyPlan     = &Resource{}
+	_ resource.ResourceWithValidateConfig = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_database_credentials_rotation",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create linode_database_credentials_rotation")
+
+	var plan ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultRotateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbID := helper.FrameworkSafeInt64ToInt(plan.DatabaseID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbType := plan.DatabaseType.ValueString()
+
+	tflog.Debug(ctx, "Resetting database credentials")
+
+	rotatedAt := time.Now().UTC()
+
+	if err := resetCredentials(ctx, client, dbType, dbID, createTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Rotate Credentials of Database %d", dbID),
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Getting database credentials")
+
+	creds, err := getCredentials(ctx, client, dbType, dbID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Credentials of Database %d", dbID),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(formatID(dbID, dbType))
+	plan.RotatedAt = types.StringValue(rotatedAt.Format(time.RFC3339))
+	plan.FlattenCredentials(creds)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read linode_database_credentials_rotation")
+
+	var state ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	dbID, dbType, err := parseID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Parse Database ID", err.Error())
+		return
+	}
+
+	state.DatabaseID = types.Int64Value(int64(dbID))
+	state.DatabaseType = types.StringValue(dbType)
+
+	ctx = populateLogAttributes(ctx, state)
+
+	tflog.Trace(ctx, "Getting database credentials")
+
+	creds, err := getCredentials(ctx, client, dbType, dbID)
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			resp.Diagnostics.AddWarning(
+				"Database Not Found",
+				fmt.Sprintf(
+					"Removing credentials rotation of database %d from state because the database "+
+						"no longer exists",
+					dbID,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Credentials of Database %d", dbID),
+			err.Error(),
+		)
+		return
+	}
+
+	state.FlattenCredentials(creds)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only applies changes to rotate_after and timeouts, every other change
+// replaces the resource to rotate the credentials.
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update linode_database_credentials_rotation")
+
+	var plan ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the rotation from state, the rotated credentials are kept.
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete linode_database_credentials_rotation")
+}
+
+func (r *Resource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data ResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RotateAfter.IsNull() || data.RotateAfter.IsUnknown() {
+		return
+	}
+
+	_, diags := data.getRotateAfter()
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// The credentials are always rotated on creation
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	due, diags := plan.rotationDue(time.Now())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !due {
+		return
+	}
+
+	// Replacing the rotation resets the credentials and lets dependent resources
+	// pick them up through replace_triggered_by.
+	plan.RotatedAt = types.StringUnknown()
+	plan.RootUsername = types.StringUnknown()
+	plan.RootPassword = types.StringUnknown()
+
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("rotated_at"))
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func populateLogAttributes(ctx context.Context, data ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"database_id":   data.DatabaseID.ValueInt64(),
+		"database_type": data.DatabaseType.ValueString(),
+	})
+}
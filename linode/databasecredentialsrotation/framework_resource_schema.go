@@ -0,0 +1,76 @@
+package databasecredentialsrotation
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the credentials rotation, in the format of database_id:database_type.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.Int64Attribute{
+			Description: "The ID of the database to rotate the root credentials of.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"database_type": schema.StringAttribute{
+			Description: "The type of the database to rotate the root credentials of.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("mysql", "postgresql"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"triggers": schema.MapAttribute{
+			Description: "A map of arbitrary values that, when changed, rotate the root credentials of the database.",
+			Optional:    true,
+			ElementType: types.StringType,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"rotate_after": schema.StringAttribute{
+			Description: "If set, the root credentials of the database are rotated on the first apply after this " +
+				"duration has passed since the last rotation, e.g. 720h.",
+			Optional: true,
+		},
+		"rotated_at": schema.StringAttribute{
+			Description: "When the root credentials of the database were last rotated by this resource.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"root_username": schema.StringAttribute{
+			Description: "The root username of the database.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"root_password": schema.StringAttribute{
+			Description: "The rotated root password of the database.",
+			Computed:    true,
+			Sensitive:   true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
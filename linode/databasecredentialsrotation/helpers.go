@@ -0,0 +1,103 @@
+package databasecredentialsrotation
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// credentials are the root credentials of a database of either engine.
+type credentials struct {
+	Username string
+	Password string
+}
+
+func getCredentials(
+	ctx context.Context,
+	client *linodego.Client,
+	engine string,
+	id int,
+) (*credentials, error) {
+	switch engine {
+	case "mysql":
+		creds, err := client.GetMySQLDatabaseCredentials(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &credentials{Username: creds.Username, Password: creds.Password}, nil
+	case "postgresql":
+		creds, err := client.GetPostgresDatabaseCredentials(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &credentials{Username: creds.Username, Password: creds.Password}, nil
+	}
+
+	return nil, fmt.Errorf("invalid database type: %s", engine)
+}
+
+// resetCredentials resets the root credentials of the database and waits for
+// the reset to finish and the database to become active again.
+func resetCredentials(
+	ctx context.Context,
+	client *linodego.Client,
+	engine string,
+	id int,
+	timeout time.Duration,
+) error {
+	timeoutSeconds, err := helper.SafeFloat64ToInt(timeout.Seconds())
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+
+	switch engine {
+	case "mysql":
+		err = client.ResetMySQLDatabaseCredentials(ctx, id)
+	case "postgresql":
+		err = client.ResetPostgresDatabaseCredentials(ctx, id)
+	default:
+		return fmt.Errorf("invalid database type: %s", engine)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.WaitForEventFinished(ctx, id, linodego.EntityDatabase,
+		linodego.ActionDatabaseCredentialsReset, start, timeoutSeconds); err != nil {
+		return fmt.Errorf("failed to wait for database credentials reset: %s", err)
+	}
+
+	// Sometimes the event has finished but the status hasn't caught up
+	if err := client.WaitForDatabaseStatus(ctx, id, linodego.DatabaseEngineType(engine),
+		linodego.DatabaseStatusActive, timeoutSeconds); err != nil {
+		return fmt.Errorf("failed to wait for database active: %s", err)
+	}
+
+	return nil
+}
+
+func formatID(dbID int, dbType string) string {
+	return fmt.Sprintf("%d:%s", dbID, dbType)
+}
+
+func parseID(id string) (int, string, error) {
+	split := strings.Split(id, ":")
+	if len(split) != 2 {
+		return 0, "", fmt.Errorf("invalid number of segments")
+	}
+
+	dbID, err := strconv.Atoi(split[0])
+	if err != nil {
+		return 0, "", err
+	}
+
+	return dbID, split[1], nil
+}
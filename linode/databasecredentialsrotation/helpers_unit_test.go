@@ -0,0 +1,66 @@
+//go:build unit
+
+package databasecredentialsrotation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch strings.TrimPrefix(r.URL.Path, "/v4") {
+		case "/databases/mysql/instances/10/credentials":
+			_, _ = w.Write([]byte(`{"username": "linroot", "password": "mysql-secret"}`))
+		case "/databases/postgresql/instances/10/credentials":
+			_, _ = w.Write([]byte(`{"username": "linpostgres", "password": "postgres-secret"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	ctx := context.Background()
+
+	creds, err := getCredentials(ctx, &client, "mysql", 10)
+	require.NoError(t, err)
+	assert.Equal(t, credentials{Username: "linroot", Password: "mysql-secret"}, *creds)
+
+	creds, err = getCredentials(ctx, &client, "postgresql", 10)
+	require.NoError(t, err)
+	assert.Equal(t, credentials{Username: "linpostgres", Password: "postgres-secret"}, *creds)
+
+	_, err = getCredentials(ctx, &client, "mysql", 11)
+	lerr, ok := err.(*linodego.Error)
+	require.True(t, ok, err)
+	assert.Equal(t, http.StatusNotFound, lerr.Code)
+
+	_, err = getCredentials(ctx, &client, "mongodb", 10)
+	assert.ErrorContains(t, err, "invalid database type: mongodb")
+}
+
+func TestParseID(t *testing.T) {
+	dbID, dbType, err := parseID(formatID(123, "postgresql"))
+	require.NoError(t, err)
+	assert.Equal(t, 123, dbID)
+	assert.Equal(t, "postgresql", dbType)
+
+	_, _, err = parseID("123")
+	assert.Error(t, err)
+
+	_, _, err = parseID("abc:mysql")
+	assert.Error(t, err)
+}
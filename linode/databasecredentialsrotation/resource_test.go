@@ -0,0 +1,143 @@
+//go:build integration
+
+package databasecredentialsrotation_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/databasecredentialsrotation/tmpl"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const resName = "linode_database_credentials_rotation.foobar"
+
+var (
+	mysqlEngineVersion    string
+	postgresEngineVersion string
+	testRegion            string
+)
+
+func init() {
+	client, err := acceptance.GetTestClient()
+	if err != nil {
+		log.Fatalf("failed to get client: %s", err)
+	}
+
+	v, err := helper.ResolveValidDBEngine(context.Background(), *client, "mysql")
+	if err != nil {
+		log.Fatalf("failed to get db engine version: %s", err)
+	}
+
+	mysqlEngineVersion = v.ID
+
+	v, err = helper.ResolveValidDBEngine(context.Background(), *client, "postgresql")
+	if err != nil {
+		log.Fatalf("failed to get db engine version: %s", err)
+	}
+
+	postgresEngineVersion = v.ID
+
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"Managed Databases"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceDatabaseCredentialsRotation_MySQL(t *testing.T) {
+	acceptance.LongRunningTest(t)
+	t.Parallel()
+
+	dbName := acctest.RandomWithPrefix("tf_test")
+
+	var password string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.MySQL(t, dbName, mysqlEngineVersion, testRegion, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resName, "database_id", "linode_database_mysql.foobar", "id"),
+					resource.TestCheckResourceAttr(resName, "database_type", "mysql"),
+					resource.TestCheckResourceAttrSet(resName, "rotated_at"),
+					resource.TestCheckResourceAttrSet(resName, "root_username"),
+					checkPassword(&password, false),
+				),
+			},
+			{
+				Config: tmpl.MySQL(t, dbName, mysqlEngineVersion, testRegion, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "triggers.rotation", "second"),
+					checkPassword(&password, true),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers", "rotate_after", "rotated_at"},
+			},
+		},
+	})
+}
+
+func TestAccResourceDatabaseCredentialsRotation_PostgreSQL(t *testing.T) {
+	acceptance.LongRunningTest(t)
+	t.Parallel()
+
+	dbName := acctest.RandomWithPrefix("tf_test")
+
+	var password string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.PostgreSQL(t, dbName, postgresEngineVersion, testRegion, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "database_type", "postgresql"),
+					resource.TestCheckResourceAttrSet(resName, "root_username"),
+					checkPassword(&password, false),
+				),
+			},
+			{
+				Config: tmpl.PostgreSQL(t, dbName, postgresEngineVersion, testRegion, "second"),
+				Check:  checkPassword(&password, true),
+			},
+		},
+	})
+}
+
+// checkPassword records the rotated password and, if rotated is set, checks that
+// it differs from the previously recorded one.
+func checkPassword(password *string, rotated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resName)
+		}
+
+		newPassword := rs.Primary.Attributes["root_password"]
+		if newPassword == "" {
+			return fmt.Errorf("expected root_password to be set")
+		}
+
+		if rotated && newPassword == *password {
+			return fmt.Errorf("expected root_password to be rotated")
+		}
+
+		*password = newPassword
+		return nil
+	}
+}
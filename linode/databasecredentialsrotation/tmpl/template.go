@@ -0,0 +1,34 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Engine  string
+	Label   string
+	Region  string
+	Trigger string
+}
+
+func MySQL(t *testing.T, label, engine, region, trigger string) string {
+	return acceptance.ExecuteTemplate(t,
+		"database_credentials_rotation_mysql", TemplateData{
+			Engine:  engine,
+			Label:   label,
+			Region:  region,
+			Trigger: trigger,
+		})
+}
+
+func PostgreSQL(t *testing.T, label, engine, region, trigger string) string {
+	return acceptance.ExecuteTemplate(t,
+		"database_credentials_rotation_postgresql", TemplateData{
+			Engine:  engine,
+			Label:   label,
+			Region:  region,
+			Trigger: trigger,
+		})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -99,17 +100,35 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		return diag.Errorf("failed to initialize event poller: %s", err)
 	}
 
-	db, err := client.CreateMySQLDatabase(ctx, linodego.MySQLCreateOptions{
-		Label:           d.Get("label").(string),
-		Region:          d.Get("region").(string),
-		Type:            d.Get("type").(string),
-		Engine:          d.Get("engine_id").(string),
-		Encrypted:       d.Get("encrypted").(bool),
-		ClusterSize:     d.Get("cluster_size").(int),
-		ReplicationType: d.Get("replication_type").(string),
-		SSLConnection:   d.Get("ssl_connection").(bool),
-		AllowList:       helper.ExpandStringSet(d.Get("allow_list").(*schema.Set)),
-	})
+	createOpts := mysqlCreateOptions{
+		MySQLCreateOptions: linodego.MySQLCreateOptions{
+			Label:           d.Get("label").(string),
+			Region:          d.Get("region").(string),
+			Type:            d.Get("type").(string),
+			Engine:          d.Get("engine_id").(string),
+			Encrypted:       d.Get("encrypted").(bool),
+			ClusterSize:     d.Get("cluster_size").(int),
+			ReplicationType: d.Get("replication_type").(string),
+			SSLConnection:   d.Get("ssl_connection").(bool),
+			AllowList:       helper.ExpandStringSet(d.Get("allow_list").(*schema.Set)),
+		},
+	}
+
+	if restoreFrom, ok := d.GetOk("restore_from"); ok {
+		fork, err := helper.ExpandDatabaseFork(
+			ctx,
+			client,
+			linodego.DatabaseEngineTypeMySQL,
+			restoreFrom.([]interface{})[0].(map[string]interface{}),
+		)
+		if err != nil {
+			return diag.Errorf("failed to read restore_from config: %s", err)
+		}
+
+		createOpts.Fork = fork
+	}
+
+	db, err := createMySQLDatabase(ctx, client, createOpts)
 	if err != nil {
 		return diag.Errorf("failed to create mysql database: %s", err)
 	}
@@ -257,3 +276,27 @@ func deleteResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		return nil
 	}))
 }
+
+// mysqlCreateOptions extends the linodego create options with the source of a
+// database provisioned from a backup or a point in time.
+// NOTE: fork is not yet supported by linodego.
+type mysqlCreateOptions struct {
+	linodego.MySQLCreateOptions
+	Fork *helper.DatabaseFork `json:"fork,omitempty"`
+}
+
+func createMySQLDatabase(
+	ctx context.Context, client linodego.Client, opts mysqlCreateOptions,
+) (*linodego.MySQLDatabase, error) {
+	if opts.Fork == nil {
+		return client.CreateMySQLDatabase(ctx, opts.MySQLCreateOptions)
+	}
+
+	return helper.DoAPIRequest[linodego.MySQLDatabase](
+		ctx,
+		&client,
+		http.MethodPost,
+		"databases/mysql/instances",
+		opts,
+	)
+}
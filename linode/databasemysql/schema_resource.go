@@ -128,6 +128,41 @@ var resourceSchema = map[string]*schema.Schema{
 		},
 	},
 
+	"restore_from": {
+		Type: schema.TypeList,
+		Description: "The backup or point in time of another Managed Database to provision this Managed " +
+			"Database from.",
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"database_id": {
+					Type:        schema.TypeInt,
+					Description: "The ID of the Managed Database to restore from.",
+					Required:    true,
+					ForceNew:    true,
+				},
+				"backup_id": {
+					Type: schema.TypeInt,
+					Description: "The ID of the backup to restore from. The database is restored from the point " +
+						"in time the backup was created at, so the backup must be within the point-in-time " +
+						"restore window of the source database.",
+					Optional:     true,
+					ForceNew:     true,
+					ExactlyOneOf: []string{"restore_from.0.backup_id", "restore_from.0.restore_time"},
+				},
+				"restore_time": {
+					Type:             schema.TypeString,
+					Description:      "The point in time to restore from, in RFC3339 format.",
+					Optional:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+				},
+			},
+		},
+	},
+
 	// Computed fields
 	"ca_cert": {
 		Type:        schema.TypeString,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -101,18 +102,36 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		return diag.Errorf("failed to initialize event poller: %s", err)
 	}
 
-	db, err := client.CreatePostgresDatabase(ctx, linodego.PostgresCreateOptions{
-		Label:                 d.Get("label").(string),
-		Region:                d.Get("region").(string),
-		Type:                  d.Get("type").(string),
-		Engine:                d.Get("engine_id").(string),
-		Encrypted:             d.Get("encrypted").(bool),
-		ClusterSize:           d.Get("cluster_size").(int),
-		ReplicationType:       linodego.PostgresReplicationType(d.Get("replication_type").(string)),
-		ReplicationCommitType: linodego.PostgresCommitType(d.Get("replication_commit_type").(string)),
-		SSLConnection:         d.Get("ssl_connection").(bool),
-		AllowList:             helper.ExpandStringSet(d.Get("allow_list").(*schema.Set)),
-	})
+	createOpts := postgresCreateOptions{
+		PostgresCreateOptions: linodego.PostgresCreateOptions{
+			Label:                 d.Get("label").(string),
+			Region:                d.Get("region").(string),
+			Type:                  d.Get("type").(string),
+			Engine:                d.Get("engine_id").(string),
+			Encrypted:             d.Get("encrypted").(bool),
+			ClusterSize:           d.Get("cluster_size").(int),
+			ReplicationType:       linodego.PostgresReplicationType(d.Get("replication_type").(string)),
+			ReplicationCommitType: linodego.PostgresCommitType(d.Get("replication_commit_type").(string)),
+			SSLConnection:         d.Get("ssl_connection").(bool),
+			AllowList:             helper.ExpandStringSet(d.Get("allow_list").(*schema.Set)),
+		},
+	}
+
+	if restoreFrom, ok := d.GetOk("restore_from"); ok {
+		fork, err := helper.ExpandDatabaseFork(
+			ctx,
+			client,
+			linodego.DatabaseEngineTypePostgres,
+			restoreFrom.([]interface{})[0].(map[string]interface{}),
+		)
+		if err != nil {
+			return diag.Errorf("failed to read restore_from config: %s", err)
+		}
+
+		createOpts.Fork = fork
+	}
+
+	db, err := createPostgresDatabase(ctx, client, createOpts)
 	if err != nil {
 		return diag.Errorf("failed to create postgresql database: %s", err)
 	}
@@ -257,3 +276,27 @@ func deleteResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		return nil
 	}))
 }
+
+// postgresCreateOptions extends the linodego create options with the source of a
+// database provisioned from a backup or a point in time.
+// NOTE: fork is not yet supported by linodego.
+type postgresCreateOptions struct {
+	linodego.PostgresCreateOptions
+	Fork *helper.DatabaseFork `json:"fork,omitempty"`
+}
+
+func createPostgresDatabase(
+	ctx context.Context, client linodego.Client, opts postgresCreateOptions,
+) (*linodego.PostgresDatabase, error) {
+	if opts.Fork == nil {
+		return client.CreatePostgresDatabase(ctx, opts.PostgresCreateOptions)
+	}
+
+	return helper.DoAPIRequest[linodego.PostgresDatabase](
+		ctx,
+		&client,
+		http.MethodPost,
+		"databases/postgresql/instances",
+		opts,
+	)
+}
@@ -3,9 +3,15 @@
 package firewall
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
 )
@@ -21,7 +27,7 @@ func compareRule(rule map[string]interface{}, expected map[string]interface{}) b
 }
 
 // Unit tests for private functions in helper
-// Functions under test: expandFirewallStatus, expandFirewallRules, flattenFirewallDeviceIDs, flattenFirewallRules, flattenFirewallDevices
+// Functions under test: expandFirewallStatus, ExpandFirewallRules, flattenFirewallDeviceIDs, FlattenFirewallRules, flattenFirewallDevices
 
 func TestExpandFirewallStatus(t *testing.T) {
 	testCases := []struct {
@@ -79,7 +85,7 @@ func TestExpandFirewallRules(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := expandFirewallRules(tc.ruleSpecs)
+			result := ExpandFirewallRules(tc.ruleSpecs)
 			if len(result) != len(tc.expected) {
 				t.Errorf("Expected %d rules, but got %d", len(tc.expected), len(result))
 			}
@@ -149,7 +155,7 @@ func TestFlattenFirewallRules(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		out := flattenFirewallRules(c.rules)
+		out := FlattenFirewallRules(c.rules)
 
 		for i, rule := range out {
 			if i < len(c.expected) {
@@ -232,3 +238,211 @@ func TestFlattenFirewallDevices(t *testing.T) {
 		}
 	}
 }
+
+func TestFilterFirewallRules(t *testing.T) {
+	rules := []linodego.FirewallRule{
+		{Label: "rule-1"},
+		{Label: "rule-2"},
+		{Label: "rule-3"},
+	}
+
+	owned := FirewallRuleLabels([]linodego.FirewallRule{{Label: "rule-3"}, {Label: "rule-1"}})
+
+	assert.Equal(t, []linodego.FirewallRule{{Label: "rule-1"}, {Label: "rule-3"}}, FilterFirewallRules(rules, owned))
+	assert.Equal(t, []linodego.FirewallRule{{Label: "rule-2"}}, ExternalFirewallRules(rules, owned))
+}
+
+func TestNormalizeFirewallRules(t *testing.T) {
+	ipv4 := []string{"10.0.0.1", "10.0.0.0/32", "10.0.0.1/32", "10.0.0.2/31"}
+	ipv6 := []string{"2001:DB8:0::/32"}
+	rules := []linodego.FirewallRule{
+		{Label: "rule-1", Addresses: linodego.NetworkAddresses{IPv4: &ipv4, IPv6: &ipv6}},
+		{Label: "rule-2"},
+	}
+
+	result := NormalizeFirewallRules(rules, false)
+	assert.Equal(t, []string{"10.0.0.1/32", "10.0.0.0/32", "10.0.0.2/31"}, *result[0].Addresses.IPv4)
+	assert.Equal(t, []string{"2001:db8::/32"}, *result[0].Addresses.IPv6)
+	assert.Nil(t, result[1].Addresses.IPv4)
+
+	result = NormalizeFirewallRules(rules, true)
+	assert.Equal(t, []string{"10.0.0.0/30"}, *result[0].Addresses.IPv4)
+
+	// The given rules are left untouched
+	assert.Equal(t, "10.0.0.1", ipv4[0])
+}
+
+func TestPreserveDeclaredAddresses(t *testing.T) {
+	remoteIPv4 := []string{"10.0.0.0/30", "192.168.0.1/32"}
+	flattened := FlattenFirewallRules([]linodego.FirewallRule{
+		{Label: "rule-1", Addresses: linodego.NetworkAddresses{IPv4: &remoteIPv4}},
+		{Label: "rule-2", Addresses: linodego.NetworkAddresses{IPv4: &remoteIPv4}},
+	})
+
+	declared := []interface{}{
+		map[string]interface{}{
+			"label":    "rule-1",
+			"action":   "ACCEPT",
+			"protocol": "TCP",
+			"ports":    "",
+			"ipv4":     []interface{}{"192.168.0.1", "10.0.0.0/31", "10.0.0.2/31"},
+			"ipv6":     []interface{}{},
+		},
+		map[string]interface{}{
+			"label":    "rule-2",
+			"action":   "ACCEPT",
+			"protocol": "TCP",
+			"ports":    "",
+			"ipv4":     []interface{}{"192.168.0.1"},
+			"ipv6":     []interface{}{},
+		},
+	}
+
+	PreserveDeclaredAddresses(flattened, declared, true)
+
+	assert.Equal(t, []string{"192.168.0.1", "10.0.0.0/31", "10.0.0.2/31"}, *flattened[0]["ipv4"].(*[]string))
+
+	// Addresses which aren't equivalent are reported as-is
+	assert.Equal(t, remoteIPv4, *flattened[1]["ipv4"].(*[]string))
+}
+
+func TestCheckFirewallRuleLimits(t *testing.T) {
+	addresses := make([]string, MaxFirewallRuleAddresses)
+	rule := linodego.FirewallRule{Label: "rule", Addresses: linodego.NetworkAddresses{IPv4: &addresses}}
+
+	assert.NoError(t, CheckFirewallRuleLimits([]linodego.FirewallRule{rule}, nil))
+
+	tooManyAddresses := append(addresses, "10.0.0.1/32")
+	assert.ErrorContains(t, CheckFirewallRuleLimits(nil, []linodego.FirewallRule{
+		{Label: "rule", Addresses: linodego.NetworkAddresses{IPv4: &tooManyAddresses}},
+	}), `outbound rule "rule" has 256 addresses`)
+
+	tooManyRules := make([]linodego.FirewallRule, MaxFirewallRulesPerDirection+1)
+	assert.ErrorContains(t, CheckFirewallRuleLimits(tooManyRules, nil), "at most 25 inbound rules")
+
+	manyRules := make([]linodego.FirewallRule, 4)
+	for i := range manyRules {
+		manyRules[i] = rule
+	}
+	assert.ErrorContains(t, CheckFirewallRuleLimits(manyRules, nil), "at most 1000 addresses across all rules")
+}
+
+func TestSplitDevices(t *testing.T) {
+	devices := []linodego.FirewallDevice{
+		{ID: 1, Entity: linodego.FirewallDeviceEntity{ID: 10, Type: linodego.FirewallDeviceLinode}},
+		{ID: 2, Entity: linodego.FirewallDeviceEntity{ID: 20, Type: linodego.FirewallDeviceLinode}},
+		{ID: 3, Entity: linodego.FirewallDeviceEntity{ID: 10, Type: linodego.FirewallDeviceNodeBalancer}},
+	}
+
+	declared, external := splitDevices(devices, expandDeviceAssignments(
+		schema.NewSet(schema.HashInt, []any{10}),
+		schema.NewSet(schema.HashInt, []any{}),
+	))
+
+	assert.Equal(t, []linodego.FirewallDevice{devices[0]}, declared)
+	assert.Equal(t, []linodego.FirewallDevice{devices[1], devices[2]}, external)
+}
+
+func TestAddedDeviceAssignments(t *testing.T) {
+	current := []firewallDeviceAssignment{
+		{ID: 10, Type: linodego.FirewallDeviceLinode},
+	}
+	updated := []firewallDeviceAssignment{
+		{ID: 10, Type: linodego.FirewallDeviceLinode},
+		{ID: 10, Type: linodego.FirewallDeviceNodeBalancer},
+	}
+
+	assert.Equal(t, updated[1:], addedDeviceAssignments(current, updated))
+	assert.Empty(t, addedDeviceAssignments(updated, current))
+}
+
+func TestCheckDeviceConflict(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch strings.TrimPrefix(r.URL.Path, "/v4") {
+		case "/linode/instances/10/firewalls":
+			_, _ = w.Write([]byte(`{"data": [{"id": 1, "label": "first"}], "page": 1, "pages": 1, "results": 1}`))
+		case "/nodebalancers/10/firewalls":
+			_, _ = w.Write([]byte(`{"data": [], "page": 1, "pages": 1, "results": 0}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	ctx := context.Background()
+
+	assert.NoError(t, CheckDeviceConflict(ctx, client, 1, 10, linodego.FirewallDeviceLinode))
+	assert.ErrorContains(t,
+		CheckDeviceConflict(ctx, client, 2, 10, linodego.FirewallDeviceLinode),
+		"linode 10 is already attached to firewall 1 (first)",
+	)
+	assert.NoError(t, CheckDeviceConflict(ctx, client, 2, 10, linodego.FirewallDeviceNodeBalancer))
+
+	// Entities which don't exist can't be attached to any firewall
+	assert.NoError(t, CheckDeviceConflict(ctx, client, 2, 20, linodego.FirewallDeviceLinode))
+
+	// Moving a device from firewall 1 to firewall 2 only warns, since the device may
+	// be detached from firewall 1 in the same apply
+	diags := checkDeviceConflicts(ctx, client, 2, []firewallDeviceAssignment{
+		{ID: 10, Type: linodego.FirewallDeviceLinode},
+		{ID: 10, Type: linodego.FirewallDeviceNodeBalancer},
+	})
+	assert.False(t, diags.HasError())
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Contains(t, diags[0].Detail, "linode 10 is already attached to firewall 1 (first)")
+	}
+
+	assert.Empty(t, checkDeviceConflicts(ctx, client, 1, []firewallDeviceAssignment{
+		{ID: 10, Type: linodego.FirewallDeviceLinode},
+	}))
+}
+
+func TestMergeFirewallRules(t *testing.T) {
+	current := []linodego.FirewallRule{
+		{Label: "team-a-http", Action: "ACCEPT", Ports: "80"},
+		{Label: "mine-ssh", Action: "ACCEPT", Ports: "22"},
+		{Label: "team-b-https", Action: "ACCEPT", Ports: "443"},
+	}
+
+	desired := []linodego.FirewallRule{
+		{Label: "mine-ssh", Action: "ACCEPT", Ports: "2222"},
+		{Label: "mine-dns", Action: "ACCEPT", Ports: "53"},
+	}
+
+	result, err := MergeFirewallRules("inbound", current, map[string]bool{"mine-ssh": true}, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, []linodego.FirewallRule{
+		{Label: "team-a-http", Action: "ACCEPT", Ports: "80"},
+		{Label: "team-b-https", Action: "ACCEPT", Ports: "443"},
+		{Label: "mine-ssh", Action: "ACCEPT", Ports: "2222"},
+		{Label: "mine-dns", Action: "ACCEPT", Ports: "53"},
+	}, result)
+
+	// Removing all owned rules leaves the external rules untouched
+	result, err = MergeFirewallRules("inbound", current, map[string]bool{"mine-ssh": true}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []linodego.FirewallRule{current[0], current[2]}, result)
+}
+
+func TestMergeFirewallRules_conflict(t *testing.T) {
+	current := []linodego.FirewallRule{
+		{Label: "team-a-http", Action: "ACCEPT", Ports: "80"},
+	}
+
+	desired := []linodego.FirewallRule{
+		{Label: "team-a-http", Action: "DROP", Ports: "80"},
+	}
+
+	_, err := MergeFirewallRules("outbound", current, nil, desired)
+	assert.ErrorContains(t, err, `outbound rule "team-a-http" is already managed outside of this resource`)
+
+	_, err = MergeFirewallRules("outbound", nil, nil, append(desired, desired...))
+	assert.ErrorContains(t, err, `duplicate outbound rule label "team-a-http"`)
+}
@@ -1,16 +1,30 @@
 package firewall
 
 import (
+	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"golang.org/x/net/context"
 )
 
+// Limits of the rules of a single firewall enforced by the Linode API.
+const (
+	MaxFirewallRulesPerDirection = 25
+	MaxFirewallRuleAddresses     = 255
+	MaxFirewallAddresses         = 1000
+)
+
+// rulesLocks holds a mutex per firewall ID which serializes
+// read-modify-write updates of the firewall's rules.
+var rulesLocks sync.Map
+
 // firewallDeviceAssignment is a helper struct intended to be used in conjunction
 // with updateFirewallDevices.
 type firewallDeviceAssignment struct {
@@ -25,7 +39,8 @@ func expandFirewallStatus(disabled interface{}) linodego.FirewallStatus {
 	}[disabled.(bool)]
 }
 
-func expandFirewallRules(ruleSpecs []interface{}) []linodego.FirewallRule {
+// ExpandFirewallRules expands the given rule blocks into firewall rules.
+func ExpandFirewallRules(ruleSpecs []interface{}) []linodego.FirewallRule {
 	rules := make([]linodego.FirewallRule, len(ruleSpecs))
 	for i, ruleSpec := range ruleSpecs {
 		ruleSpec := ruleSpec.(map[string]interface{})
@@ -49,7 +64,8 @@ func expandFirewallRules(ruleSpecs []interface{}) []linodego.FirewallRule {
 	return rules
 }
 
-func flattenFirewallRules(rules []linodego.FirewallRule) []map[string]interface{} {
+// FlattenFirewallRules flattens the given firewall rules into rule blocks.
+func FlattenFirewallRules(rules []linodego.FirewallRule) []map[string]interface{} {
 	specs := make([]map[string]interface{}, len(rules))
 	for i, rule := range rules {
 		specs[i] = map[string]interface{}{
@@ -64,6 +80,209 @@ func flattenFirewallRules(rules []linodego.FirewallRule) []map[string]interface{
 	return specs
 }
 
+// NormalizeFirewallRules normalizes the addresses of the given rules and drops
+// duplicate addresses. If aggregate is true, the addresses of each rule are merged
+// into the smallest list of CIDR ranges covering them.
+func NormalizeFirewallRules(rules []linodego.FirewallRule, aggregate bool) []linodego.FirewallRule {
+	result := make([]linodego.FirewallRule, len(rules))
+
+	for i, rule := range rules {
+		rule.Addresses.IPv4 = normalizeAddresses(rule.Addresses.IPv4, aggregate)
+		rule.Addresses.IPv6 = normalizeAddresses(rule.Addresses.IPv6, aggregate)
+		result[i] = rule
+	}
+
+	return result
+}
+
+func normalizeAddresses(addresses *[]string, aggregate bool) *[]string {
+	if addresses == nil {
+		return nil
+	}
+
+	normalize := helper.UniqueCIDRs
+	if aggregate {
+		normalize = helper.AggregateCIDRs
+	}
+
+	// Addresses are validated at plan time, so they're passed through as-is on error
+	normalized, err := normalize(*addresses)
+	if err != nil {
+		return addresses
+	}
+
+	return &normalized
+}
+
+// PreserveDeclaredAddresses replaces the addresses of the flattened rules with the
+// addresses declared for the rule with the same label if both are equivalent, so
+// formatting, order and aggregation of the declared addresses don't cause a diff.
+func PreserveDeclaredAddresses(flattened []map[string]interface{}, declared []interface{}, aggregate bool) {
+	declaredRules := make(map[string]linodego.FirewallRule, len(declared))
+	for _, rule := range ExpandFirewallRules(declared) {
+		declaredRules[rule.Label] = rule
+	}
+
+	for _, rule := range flattened {
+		declaredRule, ok := declaredRules[rule["label"].(string)]
+		if !ok {
+			continue
+		}
+
+		expected := NormalizeFirewallRules([]linodego.FirewallRule{declaredRule}, aggregate)[0]
+
+		for key, addresses := range map[string][2]*[]string{
+			"ipv4": {declaredRule.Addresses.IPv4, expected.Addresses.IPv4},
+			"ipv6": {declaredRule.Addresses.IPv6, expected.Addresses.IPv6},
+		} {
+			current, _ := rule[key].(*[]string)
+			if addresses[0] == nil || current == nil {
+				continue
+			}
+
+			if helper.CIDRListsEquivalent(*addresses[1], *current) {
+				rule[key] = addresses[0]
+			}
+		}
+	}
+}
+
+// CheckFirewallRuleLimits returns an error if the given rules exceed the number of
+// rules per direction, addresses per rule or addresses per firewall allowed by the API.
+func CheckFirewallRuleLimits(inbound, outbound []linodego.FirewallRule) error {
+	total := 0
+
+	for direction, rules := range map[string][]linodego.FirewallRule{
+		"inbound":  inbound,
+		"outbound": outbound,
+	} {
+		if len(rules) > MaxFirewallRulesPerDirection {
+			return fmt.Errorf("firewalls can have at most %d %s rules, got %d",
+				MaxFirewallRulesPerDirection, direction, len(rules))
+		}
+
+		for _, rule := range rules {
+			count := 0
+			if rule.Addresses.IPv4 != nil {
+				count += len(*rule.Addresses.IPv4)
+			}
+			if rule.Addresses.IPv6 != nil {
+				count += len(*rule.Addresses.IPv6)
+			}
+
+			if count > MaxFirewallRuleAddresses {
+				return fmt.Errorf("%s rule %q has %d addresses, at most %d are allowed per rule",
+					direction, rule.Label, count, MaxFirewallRuleAddresses)
+			}
+
+			total += count
+		}
+	}
+
+	if total > MaxFirewallAddresses {
+		return fmt.Errorf("firewalls can have at most %d addresses across all rules, got %d",
+			MaxFirewallAddresses, total)
+	}
+
+	return nil
+}
+
+// ValidateFirewallRuleLimits validates the configured rules against
+// the limits of the API at plan time.
+func ValidateFirewallRuleLimits(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("inbound") || !d.NewValueKnown("outbound") {
+		return nil
+	}
+
+	aggregate := d.Get("aggregate_addresses").(bool)
+
+	return CheckFirewallRuleLimits(
+		NormalizeFirewallRules(ExpandFirewallRules(d.Get("inbound").([]any)), aggregate),
+		NormalizeFirewallRules(ExpandFirewallRules(d.Get("outbound").([]any)), aggregate),
+	)
+}
+
+// LockFirewallRules locks the rules of the given firewall until
+// the returned function is called.
+func LockFirewallRules(id int) func() {
+	mu, _ := rulesLocks.LoadOrStore(id, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+
+	return mu.(*sync.Mutex).Unlock
+}
+
+// FirewallRuleLabels returns the set of labels of the given rules.
+func FirewallRuleLabels(rules []linodego.FirewallRule) map[string]bool {
+	labels := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		labels[rule.Label] = true
+	}
+	return labels
+}
+
+// ExternalFirewallRules returns the rules whose labels aren't in owned,
+// preserving their order.
+func ExternalFirewallRules(rules []linodego.FirewallRule, owned map[string]bool) []linodego.FirewallRule {
+	result := make([]linodego.FirewallRule, 0, len(rules))
+	for _, rule := range rules {
+		if owned[rule.Label] {
+			continue
+		}
+		result = append(result, rule)
+	}
+	return result
+}
+
+// FilterFirewallRules returns the rules whose labels are in owned,
+// preserving their order.
+func FilterFirewallRules(rules []linodego.FirewallRule, owned map[string]bool) []linodego.FirewallRule {
+	result := make([]linodego.FirewallRule, 0, len(rules))
+	for _, rule := range rules {
+		if owned[rule.Label] {
+			result = append(result, rule)
+		}
+	}
+	return result
+}
+
+// CheckFirewallRuleConflicts returns an error if a desired rule shares its label
+// with another desired rule or with an externally managed rule.
+func CheckFirewallRuleConflicts(direction string, external, desired []linodego.FirewallRule) error {
+	externalLabels := FirewallRuleLabels(external)
+	seen := make(map[string]bool, len(desired))
+
+	for _, rule := range desired {
+		if seen[rule.Label] {
+			return fmt.Errorf("duplicate %s rule label %q", direction, rule.Label)
+		}
+		seen[rule.Label] = true
+
+		if externalLabels[rule.Label] {
+			return fmt.Errorf(
+				"%s rule %q is already managed outside of this resource", direction, rule.Label)
+		}
+	}
+
+	return nil
+}
+
+// MergeFirewallRules replaces the owned rules in current with the desired rules.
+// Rules managed elsewhere keep their order and are placed before the desired rules.
+// This order is used by both linode_firewall and linode_firewall_rules.
+func MergeFirewallRules(
+	direction string,
+	current []linodego.FirewallRule,
+	owned map[string]bool,
+	desired []linodego.FirewallRule,
+) ([]linodego.FirewallRule, error) {
+	external := ExternalFirewallRules(current, owned)
+	if err := CheckFirewallRuleConflicts(direction, external, desired); err != nil {
+		return nil, err
+	}
+
+	return append(external, desired...), nil
+}
+
 func flattenFirewallDevices(devices []linodego.FirewallDevice) []map[string]interface{} {
 	governedDevices := make([]map[string]interface{}, len(devices))
 	for i, device := range devices {
@@ -78,12 +297,143 @@ func flattenFirewallDevices(devices []linodego.FirewallDevice) []map[string]inte
 	return governedDevices
 }
 
+// expandDeviceAssignments expands the given sets of Linode and NodeBalancer IDs.
+func expandDeviceAssignments(linodes, nodebalancers any) []firewallDeviceAssignment {
+	assignments := make([]firewallDeviceAssignment, 0)
+
+	for _, entityID := range helper.ExpandIntSet(linodes.(*schema.Set)) {
+		assignments = append(assignments, firewallDeviceAssignment{
+			ID:   entityID,
+			Type: linodego.FirewallDeviceLinode,
+		})
+	}
+
+	for _, entityID := range helper.ExpandIntSet(nodebalancers.(*schema.Set)) {
+		assignments = append(assignments, firewallDeviceAssignment{
+			ID:   entityID,
+			Type: linodego.FirewallDeviceNodeBalancer,
+		})
+	}
+
+	return assignments
+}
+
+// addedDeviceAssignments returns the assignments in updated which aren't in current.
+func addedDeviceAssignments(current, updated []firewallDeviceAssignment) []firewallDeviceAssignment {
+	currentMap := make(map[firewallDeviceAssignment]bool, len(current))
+	for _, assignment := range current {
+		currentMap[assignment] = true
+	}
+
+	result := make([]firewallDeviceAssignment, 0)
+	for _, assignment := range updated {
+		if !currentMap[assignment] {
+			result = append(result, assignment)
+		}
+	}
+	return result
+}
+
+// splitDevices splits the given devices into the devices in the
+// declared assignments and the devices attached elsewhere.
+func splitDevices(
+	devices []linodego.FirewallDevice,
+	declared []firewallDeviceAssignment,
+) ([]linodego.FirewallDevice, []linodego.FirewallDevice) {
+	declaredMap := make(map[firewallDeviceAssignment]bool, len(declared))
+	for _, assignment := range declared {
+		declaredMap[assignment] = true
+	}
+
+	declaredDevices := make([]linodego.FirewallDevice, 0, len(devices))
+	externalDevices := make([]linodego.FirewallDevice, 0)
+
+	for _, device := range devices {
+		if declaredMap[firewallDeviceAssignment{ID: device.Entity.ID, Type: device.Entity.Type}] {
+			declaredDevices = append(declaredDevices, device)
+		} else {
+			externalDevices = append(externalDevices, device)
+		}
+	}
+
+	return declaredDevices, externalDevices
+}
+
+// CheckDeviceConflict returns an error if the given entity is attached to
+// a firewall other than the firewall with the given ID.
+func CheckDeviceConflict(
+	ctx context.Context,
+	client linodego.Client,
+	firewallID int,
+	entityID int,
+	entityType linodego.FirewallDeviceType,
+) error {
+	var firewalls []linodego.Firewall
+	var err error
+
+	switch entityType {
+	case linodego.FirewallDeviceLinode:
+		tflog.Trace(ctx, "client.ListInstanceFirewalls(...)")
+		firewalls, err = client.ListInstanceFirewalls(ctx, entityID, nil)
+	case linodego.FirewallDeviceNodeBalancer:
+		tflog.Trace(ctx, "client.ListNodeBalancerFirewalls(...)")
+		firewalls, err = client.ListNodeBalancerFirewalls(ctx, entityID, nil)
+	default:
+		return nil
+	}
+
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			// The entity doesn't exist (yet), so it can't be attached anywhere
+			return nil
+		}
+		return fmt.Errorf("failed to list firewalls of %s %d: %w", entityType, entityID, err)
+	}
+
+	for _, firewall := range firewalls {
+		if firewall.ID != firewallID {
+			return fmt.Errorf(
+				"%s %d is already attached to firewall %d (%s); attaching it fails unless it is "+
+					"detached from that firewall first, e.g. earlier in the same apply",
+				entityType, entityID, firewall.ID, firewall.Label,
+			)
+		}
+	}
+
+	return nil
+}
+
+// checkDeviceConflicts warns about added devices which are attached to another
+// firewall. This isn't an error, since the other attachment may be removed in
+// the same apply, e.g. when a device moves between firewalls.
+func checkDeviceConflicts(
+	ctx context.Context,
+	client linodego.Client,
+	firewallID int,
+	added []firewallDeviceAssignment,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, assignment := range added {
+		if err := CheckDeviceConflict(ctx, client, firewallID, assignment.ID, assignment.Type); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Firewall device conflict",
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	return diags
+}
+
 func updateFirewallDevices(
 	ctx context.Context,
 	d *schema.ResourceData,
 	client linodego.Client,
 	id int,
 	configuredDevices []firewallDeviceAssignment,
+	ownedDevices map[firewallDeviceAssignment]bool,
 ) error {
 	currentDevices, err := client.ListFirewallDevices(ctx, id, nil)
 	if err != nil {
@@ -120,7 +470,12 @@ func updateFirewallDevices(
 	}
 
 	// Clean up remaining devices
-	for _, device := range deviceMap {
+	for assignment, device := range deviceMap {
+		if ownedDevices != nil && !ownedDevices[assignment] {
+			// The device is attached outside of this resource
+			continue
+		}
+
 		tflog.Debug(ctx, "client.DeleteFirewallDevice(...)", map[string]any{
 			"device_id": device.ID,
 		})
@@ -133,6 +488,66 @@ func updateFirewallDevices(
 	return nil
 }
 
+func updateFirewallRules(
+	ctx context.Context,
+	d *schema.ResourceData,
+	client linodego.Client,
+	id int,
+) error {
+	aggregate := d.Get("aggregate_addresses").(bool)
+	ruleSet := linodego.FirewallRuleSet{
+		Inbound:        NormalizeFirewallRules(ExpandFirewallRules(d.Get("inbound").([]any)), aggregate),
+		InboundPolicy:  d.Get("inbound_policy").(string),
+		Outbound:       NormalizeFirewallRules(ExpandFirewallRules(d.Get("outbound").([]any)), aggregate),
+		OutboundPolicy: d.Get("outbound_policy").(string),
+	}
+
+	if d.Get("ignore_external_rules").(bool) {
+		unlock := LockFirewallRules(id)
+		defer unlock()
+
+		tflog.Trace(ctx, "client.GetFirewallRules(...)")
+		current, err := client.GetFirewallRules(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		// Rules are owned by their labels. Right after the option has been enabled
+		// the previous state still holds all rules, so only the configured ones are owned.
+		oldIgnore, _ := d.GetChange("ignore_external_rules")
+
+		merge := func(direction string, desired, currentRules []linodego.FirewallRule) (
+			[]linodego.FirewallRule, error,
+		) {
+			owned := FirewallRuleLabels(desired)
+			if oldIgnore.(bool) {
+				oldRules, _ := d.GetChange(direction)
+				owned = FirewallRuleLabels(ExpandFirewallRules(oldRules.([]any)))
+			}
+
+			return MergeFirewallRules(direction, currentRules, owned, desired)
+		}
+
+		if ruleSet.Inbound, err = merge("inbound", ruleSet.Inbound, current.Inbound); err != nil {
+			return err
+		}
+		if ruleSet.Outbound, err = merge("outbound", ruleSet.Outbound, current.Outbound); err != nil {
+			return err
+		}
+
+		if err := CheckFirewallRuleLimits(ruleSet.Inbound, ruleSet.Outbound); err != nil {
+			return err
+		}
+	}
+
+	tflog.Debug(ctx, "client.UpdateFirewallRules(...)", map[string]any{
+		"rules": ruleSet,
+	})
+
+	_, err := client.UpdateFirewallRules(ctx, id, ruleSet)
+	return err
+}
+
 func populateLogAttributes(ctx context.Context, d *schema.ResourceData) context.Context {
 	return helper.SetLogFieldBulk(ctx, map[string]any{
 		"firewall_id": d.Id(),
@@ -2,6 +2,7 @@ package firewall
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
 
@@ -14,7 +15,7 @@ import (
 	linodediffs "github.com/linode/terraform-provider-linode/v2/linode/helper/customdiffs"
 )
 
-func resourceFirewallRules() *schema.Resource {
+func ResourceFirewallRules() *schema.Resource {
 	return &schema.Resource{
 		Schema: resourceRuleSchema,
 	}
@@ -36,6 +37,8 @@ func Resource() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			linodediffs.ComputedWithDefault("tags", []string{}),
 			linodediffs.CaseInsensitiveSet("tags"),
+			ValidateFirewallRuleLimits,
+			diffDevices,
 		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -82,14 +85,56 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	d.Set("status", firewall.Status)
 	d.Set("created", firewall.Created.Format(helper.TIME_FORMAT))
 	d.Set("updated", firewall.Updated.Format(helper.TIME_FORMAT))
-	d.Set("inbound", flattenFirewallRules(rules.Inbound))
-	d.Set("outbound", flattenFirewallRules(rules.Outbound))
+	inbound, outbound := rules.Inbound, rules.Outbound
+	if d.Get("ignore_external_rules").(bool) {
+		inbound = FilterFirewallRules(
+			inbound, FirewallRuleLabels(ExpandFirewallRules(d.Get("inbound").([]any))))
+		outbound = FilterFirewallRules(
+			outbound, FirewallRuleLabels(ExpandFirewallRules(d.Get("outbound").([]any))))
+	}
+
+	aggregate := d.Get("aggregate_addresses").(bool)
+
+	flattenedInbound := FlattenFirewallRules(inbound)
+	PreserveDeclaredAddresses(flattenedInbound, d.Get("inbound").([]any), aggregate)
+
+	flattenedOutbound := FlattenFirewallRules(outbound)
+	PreserveDeclaredAddresses(flattenedOutbound, d.Get("outbound").([]any), aggregate)
+
+	d.Set("inbound", flattenedInbound)
+	d.Set("outbound", flattenedOutbound)
 	d.Set("inbound_policy", firewall.Rules.InboundPolicy)
 	d.Set("outbound_policy", firewall.Rules.OutboundPolicy)
-	d.Set("linodes", AggregateEntityIDs(devices, linodego.FirewallDeviceLinode))
-	d.Set("nodebalancers", AggregateEntityIDs(devices, linodego.FirewallDeviceNodeBalancer))
+	var diags diag.Diagnostics
+	stateDevices := devices
+
+	if mode := d.Get("exclusive_devices").(string); mode != "" {
+		declared, external := splitDevices(
+			devices, expandDeviceAssignments(d.Get("linodes"), d.Get("nodebalancers")))
+
+		for _, device := range external {
+			detail := "The device is kept as exclusive_devices is set to report."
+			if mode == exclusiveDevicesRemove {
+				detail = "The device will be detached on the next apply as exclusive_devices is set to remove."
+			}
+
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary: fmt.Sprintf("%s %d (%s) is attached to firewall %d outside of linode_firewall",
+					device.Entity.Type, device.Entity.ID, device.Entity.Label, id),
+				Detail: detail,
+			})
+		}
+
+		if mode == exclusiveDevicesReport {
+			stateDevices = declared
+		}
+	}
+
+	d.Set("linodes", AggregateEntityIDs(stateDevices, linodego.FirewallDeviceLinode))
+	d.Set("nodebalancers", AggregateEntityIDs(stateDevices, linodego.FirewallDeviceNodeBalancer))
 	d.Set("devices", flattenFirewallDevices(devices))
-	return nil
+	return diags
 }
 
 func createResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -102,18 +147,22 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	createOpts.Devices.Linodes = helper.ExpandIntSet(d.Get("linodes").(*schema.Set))
 	createOpts.Devices.NodeBalancers = helper.ExpandIntSet(d.Get("nodebalancers").(*schema.Set))
-	createOpts.Rules.Inbound = expandFirewallRules(d.Get("inbound").([]any))
+	aggregate := d.Get("aggregate_addresses").(bool)
+
+	createOpts.Rules.Inbound = NormalizeFirewallRules(ExpandFirewallRules(d.Get("inbound").([]any)), aggregate)
 	createOpts.Rules.InboundPolicy = d.Get("inbound_policy").(string)
-	createOpts.Rules.Outbound = expandFirewallRules(d.Get("outbound").([]any))
+	createOpts.Rules.Outbound = NormalizeFirewallRules(ExpandFirewallRules(d.Get("outbound").([]any)), aggregate)
 	createOpts.Rules.OutboundPolicy = d.Get("outbound_policy").(string)
 
 	tflog.Debug(ctx, "client.CreateFirewall(...)", map[string]any{
 		"options": createOpts,
 	})
 
+	diags := checkDeviceConflicts(ctx, client, 0, expandDeviceAssignments(d.Get("linodes"), d.Get("nodebalancers")))
+
 	firewall, err := client.CreateFirewall(ctx, createOpts)
 	if err != nil {
-		return diag.Errorf("failed to create Firewall: %s", err)
+		return append(diags, diag.Errorf("failed to create Firewall: %s", err)...)
 	}
 	d.SetId(strconv.Itoa(firewall.ID))
 
@@ -129,11 +178,11 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		})
 
 		if _, err := client.UpdateFirewall(ctx, firewall.ID, updateOpts); err != nil {
-			return diag.Errorf("failed to disable firewall %d: %s", firewall.ID, err)
+			return append(diags, diag.Errorf("failed to disable firewall %d: %s", firewall.ID, err)...)
 		}
 	}
 
-	return readResource(ctx, d, meta)
+	return append(diags, readResource(ctx, d, meta)...)
 }
 
 func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -168,49 +217,44 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		}
 	}
 
-	inboundRules := expandFirewallRules(d.Get("inbound").([]any))
-	outboundRules := expandFirewallRules(d.Get("outbound").([]any))
-	ruleSet := linodego.FirewallRuleSet{
-		Inbound:        inboundRules,
-		InboundPolicy:  d.Get("inbound_policy").(string),
-		Outbound:       outboundRules,
-		OutboundPolicy: d.Get("outbound_policy").(string),
-	}
-
-	tflog.Debug(ctx, "client.UpdateFirewallRules(...)", map[string]any{
-		"rules": ruleSet,
-	})
-	if _, err := client.UpdateFirewallRules(ctx, id, ruleSet); err != nil {
+	if err := updateFirewallRules(ctx, d, client, id); err != nil {
 		return diag.Errorf("failed to update rules for firewall %d: %s", id, err)
 	}
 
 	linodes, linodesOk := d.GetOk("linodes")
 	nodebalancers, nodebalancersOk := d.GetOk("nodebalancers")
+	mode := d.Get("exclusive_devices").(string)
 
-	if linodesOk || nodebalancersOk {
-		assignments := make([]firewallDeviceAssignment, 0)
+	var diags diag.Diagnostics
 
-		for _, entityID := range helper.ExpandIntSet(linodes.(*schema.Set)) {
-			assignments = append(assignments, firewallDeviceAssignment{
-				ID:   entityID,
-				Type: linodego.FirewallDeviceLinode,
-			})
-		}
+	if linodesOk || nodebalancersOk || mode != "" {
+		var owned map[firewallDeviceAssignment]bool
 
-		for _, entityID := range helper.ExpandIntSet(nodebalancers.(*schema.Set)) {
-			assignments = append(assignments, firewallDeviceAssignment{
-				ID:   entityID,
-				Type: linodego.FirewallDeviceNodeBalancer,
-			})
+		if mode == exclusiveDevicesReport {
+			// Only devices previously declared by this resource are removed
+			oldLinodes, _ := d.GetChange("linodes")
+			oldNodeBalancers, _ := d.GetChange("nodebalancers")
+
+			owned = make(map[firewallDeviceAssignment]bool)
+			for _, assignment := range expandDeviceAssignments(oldLinodes, oldNodeBalancers) {
+				owned[assignment] = true
+			}
 		}
 
 		tflog.Debug(ctx, "Reconciling firewall device assignments")
-		if err := updateFirewallDevices(ctx, d, client, id, assignments); err != nil {
-			return diag.Errorf("failed to update firewall devices: %s", err)
+		assignments := expandDeviceAssignments(linodes, nodebalancers)
+
+		oldLinodes, _ := d.GetChange("linodes")
+		oldNodeBalancers, _ := d.GetChange("nodebalancers")
+		added := addedDeviceAssignments(expandDeviceAssignments(oldLinodes, oldNodeBalancers), assignments)
+		diags = checkDeviceConflicts(ctx, client, id, added)
+
+		if err := updateFirewallDevices(ctx, d, client, id, assignments, owned); err != nil {
+			return append(diags, diag.Errorf("failed to update firewall devices: %s", err)...)
 		}
 	}
 
-	return nil
+	return diags
 }
 
 func deleteResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -228,3 +272,20 @@ func deleteResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	}
 	return nil
 }
+
+func diffDevices(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Get("exclusive_devices").(string) == exclusiveDevicesRemove {
+		// Devices which aren't declared are detached, so unset lists mean no devices
+		for _, key := range []string{"linodes", "nodebalancers"} {
+			if !d.GetRawConfig().GetAttr(key).IsNull() {
+				continue
+			}
+
+			if err := d.SetNew(key, []any{}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
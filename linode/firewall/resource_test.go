@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
 	acceptanceTmpl "github.com/linode/terraform-provider-linode/v2/linode/acceptance/tmpl"
@@ -484,3 +485,76 @@ func TestAccLinodeFirewall_noRules(t *testing.T) {
 		},
 	})
 }
+
+func TestAccLinodeFirewall_normalizedAddresses(t *testing.T) {
+	t.Parallel()
+
+	var firewall linodego.Firewall
+	name := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acceptanceTmpl.ProviderNoPoll(t) + tmpl.NormalizedAddresses(t, name),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckFirewallExists(testFirewallResName, &firewall),
+					func(s *terraform.State) error {
+						ipv4 := *firewall.Rules.Inbound[0].Addresses.IPv4
+						if !helper.CIDRListsEquivalent(ipv4, []string{"10.0.0.0/24", "192.168.1.1/32"}) {
+							return fmt.Errorf("expected aggregated IPv4 addresses, got %v", ipv4)
+						}
+
+						ipv6 := *firewall.Rules.Inbound[0].Addresses.IPv6
+						if !helper.CIDRListsEquivalent(ipv6, []string{"2001:db8::/127"}) {
+							return fmt.Errorf("expected aggregated IPv6 addresses, got %v", ipv6)
+						}
+
+						return nil
+					},
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.0.ipv4.#", "4"),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.0.ipv4.0", "192.168.1.1"),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.0.ipv6.#", "2"),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.0.ipv6.0", "2001:DB8:0::1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeFirewall_exclusiveDevices(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf_test")
+	devicePrefix := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acceptanceTmpl.ProviderNoPoll(t) + tmpl.ExclusiveDevices(t, name, devicePrefix, testRegion),
+			},
+			{
+				// The device attached through linode_firewall_device is kept and not shown as drift
+				Config: acceptanceTmpl.ProviderNoPoll(t) + tmpl.ExclusiveDevices(t, name, devicePrefix, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testFirewallResName, "exclusive_devices", "report"),
+					resource.TestCheckResourceAttr(testFirewallResName, "linodes.#", "0"),
+					resource.TestCheckResourceAttr(testFirewallResName, "devices.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						testFirewallResName, "devices.0.entity_id", "linode_instance.one", "id"),
+				),
+			},
+			{
+				// The device is moved to another firewall in a single apply
+				Config: acceptanceTmpl.ProviderNoPoll(t) + tmpl.ExclusiveDevicesMove(t, name, devicePrefix, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"linode_firewall_device.test", "firewall_id", "linode_firewall.other", "id"),
+				),
+			},
+		},
+	})
+}
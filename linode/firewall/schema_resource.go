@@ -8,6 +8,11 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
+const (
+	exclusiveDevicesReport = "report"
+	exclusiveDevicesRemove = "remove"
+)
+
 // RegExp for firewall label validation: "^[a-zA-Z0-9]([-_.]?[a-zA-Z0-9]+)*[a-zA-Z0-9]$"
 
 var resourceRuleSchema = map[string]*schema.Schema{
@@ -39,20 +44,23 @@ var resourceRuleSchema = map[string]*schema.Schema{
 		Type: schema.TypeList,
 		Elem: &schema.Schema{
 			Type:             schema.TypeString,
-			ValidateDiagFunc: helper.SDKv2ValidateIPv4Range,
+			ValidateDiagFunc: helper.SDKv2ValidateIPv4AddressOrRange,
+			DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+				// We handle validation separately
+				return helper.CompareCIDRs(oldValue, newValue)
+			},
 		},
-		Description: "A list of CIDR blocks or 0.0.0.0/0 (to allow all) this rule applies to.",
+		Description: "A list of IPv4 addresses, CIDR blocks or 0.0.0.0/0 (to allow all) this rule applies to.",
 		Optional:    true,
 	},
 	"ipv6": {
 		Type: schema.TypeList,
 		Elem: &schema.Schema{
 			Type:             schema.TypeString,
-			ValidateDiagFunc: helper.SDKv2ValidateIPv6Range,
+			ValidateDiagFunc: helper.SDKv2ValidateIPv6AddressOrRange,
 			DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
 				// We handle validation separately
-				result, _ := helper.CompareIPv6Ranges(oldValue, newValue)
-				return result
+				return helper.CompareCIDRs(oldValue, newValue)
 			},
 		},
 		Description: "A list of IPv6 addresses or networks this rule applies to.",
@@ -112,7 +120,7 @@ var resourceSchema = map[string]*schema.Schema{
 	},
 	"inbound": {
 		Type:        schema.TypeList,
-		Elem:        resourceFirewallRules(),
+		Elem:        ResourceFirewallRules(),
 		Description: "A firewall rule that specifies what inbound network traffic is allowed.",
 		Optional:    true,
 	},
@@ -124,7 +132,7 @@ var resourceSchema = map[string]*schema.Schema{
 	},
 	"outbound": {
 		Type:        schema.TypeList,
-		Elem:        resourceFirewallRules(),
+		Elem:        ResourceFirewallRules(),
 		Description: "A firewall rule that specifies what outbound network traffic is allowed.",
 		Optional:    true,
 	},
@@ -134,6 +142,27 @@ var resourceSchema = map[string]*schema.Schema{
 			"the outbound.action property for an individual Firewall Rule.",
 		Required: true,
 	},
+	"aggregate_addresses": {
+		Type: schema.TypeBool,
+		Description: "If true, the addresses of each rule are merged into the smallest list of " +
+			"CIDR ranges covering them before being sent to the API.",
+		Optional: true,
+		Default:  false,
+	},
+	"ignore_external_rules": {
+		Type: schema.TypeBool,
+		Description: "If true, rules whose labels aren't declared in this resource are left untouched, " +
+			"e.g. rules managed by linode_firewall_rules resources.",
+		Optional: true,
+		Default:  false,
+	},
+	"exclusive_devices": {
+		Type: schema.TypeString,
+		Description: "How devices attached to the Firewall outside of linodes and nodebalancers are handled. " +
+			"`report` keeps them and reports them as warnings, `remove` reports and detaches them.",
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{exclusiveDevicesReport, exclusiveDevicesRemove}, false),
+	},
 	"linodes": {
 		Type:        schema.TypeSet,
 		Elem:        &schema.Schema{Type: schema.TypeInt},
@@ -129,3 +129,42 @@ func DataBasic(t *testing.T, label, devicePrefix, region string) string {
 			NodeBalancers: resources,
 		})
 }
+
+func NormalizedAddresses(t *testing.T, label string) string {
+	return acceptance.ExecuteTemplate(t,
+		"firewall_normalized_addresses", TemplateData{
+			Label: label,
+		})
+}
+
+func ExclusiveDevices(t *testing.T, label, devicePrefix, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"firewall_exclusive_devices", TemplateData{
+			Label: label,
+			Instances: []ResourceTemplateData{
+				{
+					Prefix:   devicePrefix,
+					ID:       "one",
+					PubKey:   acceptance.PublicKeyMaterial,
+					Region:   region,
+					RootPass: acctest.RandString(12),
+				},
+			},
+		})
+}
+
+func ExclusiveDevicesMove(t *testing.T, label, devicePrefix, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"firewall_exclusive_devices_move", TemplateData{
+			Label: label,
+			Instances: []ResourceTemplateData{
+				{
+					Prefix:   devicePrefix,
+					ID:       "one",
+					PubKey:   acceptance.PublicKeyMaterial,
+					Region:   region,
+					RootPass: acctest.RandString(12),
+				},
+			},
+		})
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/firewall"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
@@ -82,6 +84,57 @@ func (r *Resource) Create(
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Only devices which are about to be attached are checked for conflicts
+	if !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.Meta == nil {
+		return
+	}
+
+	var plan FirewallDeviceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.FirewallID.IsUnknown() || plan.EntityID.IsUnknown() || plan.EntityType.IsUnknown() {
+		return
+	}
+
+	entityID := helper.FrameworkSafeInt64ToInt(
+		plan.EntityID.ValueInt64(),
+		&resp.Diagnostics,
+	)
+	firewallID := helper.FrameworkSafeInt64ToInt(
+		plan.FirewallID.ValueInt64(),
+		&resp.Diagnostics,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only attachments to other firewalls are conflicts. They are warnings since the
+	// other attachment may be removed in the same apply, e.g. when moving the entity.
+	err := firewall.CheckDeviceConflict(
+		ctx,
+		*r.Meta.Client,
+		firewallID,
+		entityID,
+		linodego.FirewallDeviceType(plan.EntityType.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("entity_id"),
+			"Firewall Device Conflict",
+			err.Error(),
+		)
+	}
+}
+
 func (r *Resource) Read(
 	ctx context.Context,
 	req resource.ReadRequest,
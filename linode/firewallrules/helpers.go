@@ -0,0 +1,102 @@
+package firewallrules
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/firewall"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// ownedLabels returns the labels of the given rule blocks.
+func ownedLabels(ruleSpecs any) map[string]bool {
+	return firewall.FirewallRuleLabels(firewall.ExpandFirewallRules(ruleSpecs.([]any)))
+}
+
+// parseImportID parses an import ID of the form <firewall_id>:<label>[,<label>...].
+func parseImportID(importID string) (int, map[string]bool, error) {
+	firewallID, labelList, ok := strings.Cut(importID, ":")
+	if !ok || labelList == "" {
+		return 0, nil, fmt.Errorf(
+			"invalid import ID %q, expected <firewall_id>:<label>[,<label>...]", importID)
+	}
+
+	id, err := strconv.Atoi(firewallID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to parse firewall ID %q: %w", firewallID, err)
+	}
+
+	labels := make(map[string]bool)
+	for _, label := range strings.Split(labelList, ",") {
+		if label = strings.TrimSpace(label); label != "" {
+			labels[label] = true
+		}
+	}
+
+	if len(labels) == 0 {
+		return 0, nil, fmt.Errorf("import ID %q doesn't list any rule labels", importID)
+	}
+
+	return id, labels, nil
+}
+
+// expandRules expands the given rule blocks with normalized addresses.
+func expandRules(d *schema.ResourceData, ruleSpecs any) []linodego.FirewallRule {
+	return firewall.NormalizeFirewallRules(
+		firewall.ExpandFirewallRules(ruleSpecs.([]any)),
+		d.Get("aggregate_addresses").(bool),
+	)
+}
+
+// updateRules merges the desired rules into the current rules of the firewall.
+// Rules previously owned by the resource which aren't desired anymore are removed.
+func updateRules(
+	ctx context.Context,
+	client linodego.Client,
+	id int,
+	ownedInbound map[string]bool,
+	desiredInbound []linodego.FirewallRule,
+	ownedOutbound map[string]bool,
+	desiredOutbound []linodego.FirewallRule,
+) error {
+	unlock := firewall.LockFirewallRules(id)
+	defer unlock()
+
+	tflog.Trace(ctx, "client.GetFirewallRules(...)")
+	ruleSet, err := client.GetFirewallRules(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if ruleSet.Inbound, err = firewall.MergeFirewallRules("inbound", ruleSet.Inbound, ownedInbound, desiredInbound); err != nil {
+		return err
+	}
+	if ruleSet.Outbound, err = firewall.MergeFirewallRules("outbound", ruleSet.Outbound, ownedOutbound, desiredOutbound); err != nil {
+		return err
+	}
+
+	// The limits apply to the merged rules, including those managed elsewhere
+	if err := firewall.CheckFirewallRuleLimits(ruleSet.Inbound, ruleSet.Outbound); err != nil {
+		return fmt.Errorf(
+			"the rules of firewall %d would exceed the limits of the API once merged with the rules "+
+				"managed outside of this resource: %w", id, err)
+	}
+
+	tflog.Debug(ctx, "client.UpdateFirewallRules(...)", map[string]any{
+		"rules": ruleSet,
+	})
+
+	_, err = client.UpdateFirewallRules(ctx, id, *ruleSet)
+	return err
+}
+
+func populateLogAttributes(ctx context.Context, d *schema.ResourceData) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"firewall_id": d.Id(),
+	})
+}
@@ -0,0 +1,26 @@
+//go:build unit
+
+package firewallrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImportID(t *testing.T) {
+	id, labels, err := parseImportID("123:team-a-ssh, team-a-icmp")
+	require.NoError(t, err)
+	assert.Equal(t, 123, id)
+	assert.Equal(t, map[string]bool{"team-a-ssh": true, "team-a-icmp": true}, labels)
+
+	_, _, err = parseImportID("123")
+	assert.ErrorContains(t, err, "expected <firewall_id>:<label>[,<label>...]")
+
+	_, _, err = parseImportID("123: , ")
+	assert.ErrorContains(t, err, "doesn't list any rule labels")
+
+	_, _, err = parseImportID("abc:team-a-ssh")
+	assert.ErrorContains(t, err, "failed to parse firewall ID")
+}
@@ -0,0 +1,164 @@
+package firewallrules
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/firewall"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func Resource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceSchema,
+		ReadContext:   readResource,
+		CreateContext: createResource,
+		UpdateContext: updateResource,
+		DeleteContext: deleteResource,
+		CustomizeDiff: firewall.ValidateFirewallRuleLimits,
+		Importer: &schema.ResourceImporter{
+			StateContext: importResource,
+		},
+	}
+}
+
+// importResource imports the rules with the given labels of a firewall, using an
+// ID of the form <firewall_id>:<label>[,<label>...]. Only the listed rules are
+// adopted, so rules owned by other resources are left alone on the next apply.
+func importResource(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*helper.ProviderMeta).Client
+
+	id, labels, err := parseImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Trace(ctx, "client.GetFirewallRules(...)")
+	rules, err := client.GetFirewallRules(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rules for firewall %d: %w", id, err)
+	}
+
+	inbound := firewall.FilterFirewallRules(rules.Inbound, labels)
+	outbound := firewall.FilterFirewallRules(rules.Outbound, labels)
+
+	found := firewall.FirewallRuleLabels(append(append([]linodego.FirewallRule{}, inbound...), outbound...))
+	for label := range labels {
+		if !found[label] {
+			return nil, fmt.Errorf("firewall %d has no rule labeled %q", id, label)
+		}
+	}
+
+	d.SetId(strconv.Itoa(id))
+	d.Set("firewall_id", id)
+	d.Set("aggregate_addresses", false)
+	d.Set("inbound", firewall.FlattenFirewallRules(inbound))
+	d.Set("outbound", firewall.FlattenFirewallRules(outbound))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func readResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	ctx = populateLogAttributes(ctx, d)
+	tflog.Debug(ctx, "Read linode_firewall_rules")
+
+	client := meta.(*helper.ProviderMeta).Client
+	id := d.Get("firewall_id").(int)
+
+	tflog.Trace(ctx, "client.GetFirewallRules(...)")
+	rules, err := client.GetFirewallRules(ctx, id)
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] removing rules of Linode Firewall ID %d from state because it no longer exists", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to get rules for firewall %d: %s", id, err)
+	}
+
+	aggregate := d.Get("aggregate_addresses").(bool)
+
+	inbound := firewall.FlattenFirewallRules(
+		firewall.FilterFirewallRules(rules.Inbound, ownedLabels(d.Get("inbound"))))
+	firewall.PreserveDeclaredAddresses(inbound, d.Get("inbound").([]any), aggregate)
+
+	outbound := firewall.FlattenFirewallRules(
+		firewall.FilterFirewallRules(rules.Outbound, ownedLabels(d.Get("outbound"))))
+	firewall.PreserveDeclaredAddresses(outbound, d.Get("outbound").([]any), aggregate)
+
+	d.Set("inbound", inbound)
+	d.Set("outbound", outbound)
+
+	return nil
+}
+
+func createResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*helper.ProviderMeta).Client
+	id := d.Get("firewall_id").(int)
+
+	d.SetId(strconv.Itoa(id))
+	ctx = populateLogAttributes(ctx, d)
+	tflog.Debug(ctx, "Create linode_firewall_rules")
+
+	err := updateRules(
+		ctx, client, id,
+		nil, expandRules(d, d.Get("inbound")),
+		nil, expandRules(d, d.Get("outbound")),
+	)
+	if err != nil {
+		d.SetId("")
+		return diag.Errorf("failed to create rules for firewall %d: %s", id, err)
+	}
+
+	return readResource(ctx, d, meta)
+}
+
+func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	ctx = populateLogAttributes(ctx, d)
+	tflog.Debug(ctx, "Update linode_firewall_rules")
+
+	client := meta.(*helper.ProviderMeta).Client
+	id := d.Get("firewall_id").(int)
+
+	oldInbound, newInbound := d.GetChange("inbound")
+	oldOutbound, newOutbound := d.GetChange("outbound")
+
+	err := updateRules(
+		ctx, client, id,
+		ownedLabels(oldInbound), expandRules(d, newInbound),
+		ownedLabels(oldOutbound), expandRules(d, newOutbound),
+	)
+	if err != nil {
+		return diag.Errorf("failed to update rules for firewall %d: %s", id, err)
+	}
+
+	return readResource(ctx, d, meta)
+}
+
+func deleteResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	ctx = populateLogAttributes(ctx, d)
+	tflog.Debug(ctx, "Delete linode_firewall_rules")
+
+	client := meta.(*helper.ProviderMeta).Client
+	id := d.Get("firewall_id").(int)
+
+	err := updateRules(
+		ctx, client, id,
+		ownedLabels(d.Get("inbound")), nil,
+		ownedLabels(d.Get("outbound")), nil,
+	)
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			return nil
+		}
+		return diag.Errorf("failed to delete rules for firewall %d: %s", id, err)
+	}
+
+	return nil
+}
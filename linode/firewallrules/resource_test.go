@@ -0,0 +1,132 @@
+//go:build integration
+
+package firewallrules_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/firewallrules/tmpl"
+)
+
+const (
+	testFirewallResName = "linode_firewall.test"
+	testTeamAResName    = "linode_firewall_rules.team_a"
+	testTeamBResName    = "linode_firewall_rules.team_b"
+)
+
+func TestAccResourceFirewallRules_basic(t *testing.T) {
+	t.Parallel()
+
+	var firewall linodego.Firewall
+	name := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, name),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckFirewallExists(testFirewallResName, &firewall),
+					checkFirewallRuleLabels(&firewall,
+						[]string{"tf-test-in", "team-a-ssh", "team-b-https"},
+						[]string{"team-b-dns"},
+					),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.#", "1"),
+					resource.TestCheckResourceAttr(testFirewallResName, "outbound.#", "0"),
+					resource.TestCheckResourceAttr(testTeamAResName, "inbound.#", "1"),
+					resource.TestCheckResourceAttr(testTeamAResName, "inbound.0.label", "team-a-ssh"),
+					resource.TestCheckResourceAttr(testTeamAResName, "inbound.0.ports", "22"),
+					resource.TestCheckResourceAttr(testTeamAResName, "outbound.#", "0"),
+					resource.TestCheckResourceAttr(testTeamBResName, "inbound.#", "1"),
+					resource.TestCheckResourceAttr(testTeamBResName, "inbound.0.label", "team-b-https"),
+					resource.TestCheckResourceAttr(testTeamBResName, "outbound.#", "1"),
+					resource.TestCheckResourceAttr(testTeamBResName, "outbound.0.label", "team-b-dns"),
+					resource.TestCheckResourceAttr(testTeamBResName, "outbound.0.protocol", "UDP"),
+				),
+			},
+			{
+				Config: tmpl.Updates(t, name),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckFirewallExists(testFirewallResName, &firewall),
+					checkFirewallRuleLabels(&firewall,
+						[]string{"tf-test-in", "team-a-ssh", "team-a-icmp"},
+						[]string{},
+					),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.#", "1"),
+					resource.TestCheckResourceAttr(testTeamAResName, "inbound.#", "2"),
+					resource.TestCheckResourceAttr(testTeamAResName, "inbound.0.ports", "2222"),
+					resource.TestCheckResourceAttr(testTeamAResName, "inbound.1.label", "team-a-icmp"),
+				),
+			},
+			{
+				// Only the rules with the given labels are imported
+				ResourceName: testTeamAResName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[testTeamAResName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", testTeamAResName)
+					}
+
+					return rs.Primary.ID + ":team-a-ssh,team-a-icmp", nil
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported state, got %d", len(states))
+					}
+
+					if inbound := states[0].Attributes["inbound.#"]; inbound != "2" {
+						return fmt.Errorf("expected 2 imported inbound rules, got %s", inbound)
+					}
+
+					if outbound := states[0].Attributes["outbound.#"]; outbound != "0" {
+						return fmt.Errorf("expected 0 imported outbound rules, got %s", outbound)
+					}
+
+					return nil
+				},
+			},
+			{
+				Config:      tmpl.Conflict(t, name),
+				ExpectError: regexp.MustCompile(`inbound rule "team-a-ssh" is already managed outside of this resource`),
+			},
+		},
+	})
+}
+
+func checkFirewallRuleLabels(firewall *linodego.Firewall, inbound, outbound []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if err := compareRuleLabels("inbound", firewall.Rules.Inbound, inbound); err != nil {
+			return err
+		}
+
+		return compareRuleLabels("outbound", firewall.Rules.Outbound, outbound)
+	}
+}
+
+func compareRuleLabels(direction string, rules []linodego.FirewallRule, expected []string) error {
+	if len(rules) != len(expected) {
+		return fmt.Errorf("expected %d %s rules, got %d", len(expected), direction, len(rules))
+	}
+
+	labels := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		labels[rule.Label] = true
+	}
+
+	for _, label := range expected {
+		if !labels[label] {
+			return fmt.Errorf("expected %s rule %q to exist", direction, label)
+		}
+	}
+
+	return nil
+}
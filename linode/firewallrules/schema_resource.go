@@ -0,0 +1,36 @@
+package firewallrules
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/terraform-provider-linode/v2/linode/firewall"
+)
+
+var resourceSchema = map[string]*schema.Schema{
+	"firewall_id": {
+		Type:        schema.TypeInt,
+		Description: "The ID of the Firewall to manage the rules of.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	"aggregate_addresses": {
+		Type: schema.TypeBool,
+		Description: "If true, the addresses of each rule are merged into the smallest list of " +
+			"CIDR ranges covering them before being sent to the API.",
+		Optional: true,
+		Default:  false,
+	},
+	"inbound": {
+		Type:         schema.TypeList,
+		Elem:         firewall.ResourceFirewallRules(),
+		Description:  "An inbound rule managed by this resource. Rules are identified by their label.",
+		Optional:     true,
+		AtLeastOneOf: []string{"inbound", "outbound"},
+	},
+	"outbound": {
+		Type:         schema.TypeList,
+		Elem:         firewall.ResourceFirewallRules(),
+		Description:  "An outbound rule managed by this resource. Rules are identified by their label.",
+		Optional:     true,
+		AtLeastOneOf: []string{"inbound", "outbound"},
+	},
+}
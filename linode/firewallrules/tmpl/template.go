@@ -0,0 +1,32 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label string
+}
+
+func Basic(t *testing.T, label string) string {
+	return acceptance.ExecuteTemplate(t,
+		"firewall_rules_basic", TemplateData{
+			Label: label,
+		})
+}
+
+func Updates(t *testing.T, label string) string {
+	return acceptance.ExecuteTemplate(t,
+		"firewall_rules_updates", TemplateData{
+			Label: label,
+		})
+}
+
+func Conflict(t *testing.T, label string) string {
+	return acceptance.ExecuteTemplate(t,
+		"firewall_rules_conflict", TemplateData{
+			Label: label,
+		})
+}
@@ -0,0 +1,65 @@
+//go:build integration
+
+package firewallruleset_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/firewallruleset/tmpl"
+)
+
+const (
+	testDataSourceName  = "data.linode_firewall_ruleset.test"
+	testFirewallResName = "linode_firewall.test"
+)
+
+func TestAccDataSourceFirewallRuleSet_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.DataBasic(t, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testDataSourceName, "id"),
+					resource.TestCheckResourceAttr(testDataSourceName, "inbound_policy", "DROP"),
+					resource.TestCheckResourceAttr(testDataSourceName, "outbound_policy", "ACCEPT"),
+					resource.TestCheckResourceAttr(testDataSourceName, "inbound.#", "2"),
+					resource.TestCheckResourceAttr(testDataSourceName, "inbound.0.label", "allow-web"),
+					resource.TestCheckResourceAttr(testDataSourceName, "inbound.0.ports", "80, 443, 8000-8080"),
+					resource.TestCheckResourceAttr(testDataSourceName, "inbound.1.ipv4.#", "2"),
+					resource.TestCheckResourceAttr(testDataSourceName, "inbound.1.ipv4.1", "198.51.100.7/32"),
+					resource.TestCheckResourceAttr(testDataSourceName, "inbound.1.ipv6.0", "2001:db8::/32"),
+					resource.TestCheckResourceAttr(testDataSourceName, "outbound.#", "1"),
+
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.#", "2"),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.1.label", "allow-ssh"),
+					resource.TestCheckResourceAttr(testFirewallResName, "outbound.0.label", "drop-smtp"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceFirewallRuleSet_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      tmpl.DataInvalid(t),
+				ExpectError: regexp.MustCompile(`unknown address group "missing"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,38 @@
+package firewallruleset
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/linode/terraform-provider-linode/v2/linode/firewall"
+)
+
+var frameworkDataSourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The SHA-256 checksum of the rule set document.",
+			Computed:    true,
+		},
+		"document": schema.StringAttribute{
+			Description: "A JSON or YAML document describing the inbound and outbound rules, " +
+				"the default policies and named address groups.",
+			Required: true,
+		},
+		"inbound": schema.ListAttribute{
+			ElementType: firewall.RuleObjectType,
+			Description: "The inbound rules of the rule set.",
+			Computed:    true,
+		},
+		"inbound_policy": schema.StringAttribute{
+			Description: "The default behavior for inbound traffic, if specified in the document.",
+			Computed:    true,
+		},
+		"outbound": schema.ListAttribute{
+			ElementType: firewall.RuleObjectType,
+			Description: "The outbound rules of the rule set.",
+			Computed:    true,
+		},
+		"outbound_policy": schema.StringAttribute{
+			Description: "The default behavior for outbound traffic, if specified in the document.",
+			Computed:    true,
+		},
+	},
+}
@@ -0,0 +1,55 @@
+package firewallruleset
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/firewall"
+)
+
+type DataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Document       types.String `tfsdk:"document"`
+	Inbound        types.List   `tfsdk:"inbound"`
+	InboundPolicy  types.String `tfsdk:"inbound_policy"`
+	Outbound       types.List   `tfsdk:"outbound"`
+	OutboundPolicy types.String `tfsdk:"outbound_policy"`
+}
+
+func (data *DataSourceModel) ParseRuleSet(
+	ctx context.Context,
+	ruleSet *linodego.FirewallRuleSet,
+) diag.Diagnostics {
+	checksum := sha256.Sum256([]byte(data.Document.ValueString()))
+	data.ID = types.StringValue(hex.EncodeToString(checksum[:]))
+
+	inbound, diags := firewall.ParseFirewallRules(ctx, ruleSet.Inbound)
+	if diags.HasError() {
+		return diags
+	}
+	data.Inbound = *inbound
+
+	outbound, diags := firewall.ParseFirewallRules(ctx, ruleSet.Outbound)
+	if diags.HasError() {
+		return diags
+	}
+	data.Outbound = *outbound
+
+	data.InboundPolicy = parsePolicy(ruleSet.InboundPolicy)
+	data.OutboundPolicy = parsePolicy(ruleSet.OutboundPolicy)
+
+	return nil
+}
+
+// parsePolicy returns a null value for policies not specified in the document.
+func parsePolicy(policy string) types.String {
+	if policy == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(policy)
+}
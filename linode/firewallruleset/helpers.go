@@ -0,0 +1,254 @@
+package firewallruleset
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/firewall"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	validActions   = []string{"ACCEPT", "DROP"}
+	validProtocols = []linodego.NetworkProtocol{linodego.TCP, linodego.UDP, linodego.ICMP, linodego.IPENCAP}
+)
+
+// ruleSetDocument is the structure of a JSON or YAML rule set document.
+type ruleSetDocument struct {
+	AddressGroups  map[string]addressGroup `json:"address_groups"`
+	Inbound        []ruleDocument          `json:"inbound"`
+	InboundPolicy  string                  `json:"inbound_policy"`
+	Outbound       []ruleDocument          `json:"outbound"`
+	OutboundPolicy string                  `json:"outbound_policy"`
+}
+
+// addressGroup is a named list of addresses which can be referenced by rules.
+type addressGroup struct {
+	IPv4 []string `json:"ipv4"`
+	IPv6 []string `json:"ipv6"`
+}
+
+type ruleDocument struct {
+	Label         string     `json:"label"`
+	Action        string     `json:"action"`
+	Protocol      string     `json:"protocol"`
+	Ports         []portSpec `json:"ports"`
+	IPv4          []string   `json:"ipv4"`
+	IPv6          []string   `json:"ipv6"`
+	AddressGroups []string   `json:"address_groups"`
+}
+
+// portSpec is a single port or a port range (e.g. `80-90`),
+// given as either a number or a string.
+type portSpec string
+
+func (p *portSpec) UnmarshalJSON(data []byte) error {
+	var port int
+	if err := json.Unmarshal(data, &port); err == nil {
+		*p = portSpec(strconv.Itoa(port))
+		return nil
+	}
+
+	var spec string
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return fmt.Errorf("port must be a number or a string: %s", data)
+	}
+
+	*p = portSpec(strings.ReplaceAll(spec, " ", ""))
+
+	return nil
+}
+
+// parseRuleSet parses the given JSON or YAML document into a firewall rule set.
+func parseRuleSet(document string) (*linodego.FirewallRuleSet, error) {
+	var doc ruleSetDocument
+
+	// JSON is valid YAML, so both formats are handled by the YAML parser
+	if err := yaml.UnmarshalStrict([]byte(document), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse rule set document: %w", err)
+	}
+
+	return doc.expand()
+}
+
+func (doc *ruleSetDocument) expand() (*linodego.FirewallRuleSet, error) {
+	for name, group := range doc.AddressGroups {
+		if err := validateAddresses(group.IPv4, group.IPv6); err != nil {
+			return nil, fmt.Errorf("address group %q: %w", name, err)
+		}
+	}
+
+	doc.InboundPolicy = strings.ToUpper(doc.InboundPolicy)
+	doc.OutboundPolicy = strings.ToUpper(doc.OutboundPolicy)
+
+	for _, policy := range []string{doc.InboundPolicy, doc.OutboundPolicy} {
+		if policy != "" && !slices.Contains(validActions, policy) {
+			return nil, fmt.Errorf("invalid policy %q, expected one of %s", policy, strings.Join(validActions, ", "))
+		}
+	}
+
+	inbound, err := doc.expandRules("inbound", doc.Inbound)
+	if err != nil {
+		return nil, err
+	}
+
+	outbound, err := doc.expandRules("outbound", doc.Outbound)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := firewall.CheckFirewallRuleLimits(inbound, outbound); err != nil {
+		return nil, err
+	}
+
+	return &linodego.FirewallRuleSet{
+		Inbound:        inbound,
+		InboundPolicy:  doc.InboundPolicy,
+		Outbound:       outbound,
+		OutboundPolicy: doc.OutboundPolicy,
+	}, nil
+}
+
+func (doc *ruleSetDocument) expandRules(direction string, ruleDocs []ruleDocument) ([]linodego.FirewallRule, error) {
+	rules := make([]linodego.FirewallRule, len(ruleDocs))
+
+	for i, ruleDoc := range ruleDocs {
+		rule, err := doc.expandRule(ruleDoc)
+		if err != nil {
+			return nil, fmt.Errorf("%s rule %d (%q): %w", direction, i, ruleDoc.Label, err)
+		}
+
+		rules[i] = rule
+	}
+
+	if err := firewall.CheckFirewallRuleConflicts(direction, nil, rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (doc *ruleSetDocument) expandRule(ruleDoc ruleDocument) (linodego.FirewallRule, error) {
+	var rule linodego.FirewallRule
+
+	if ruleDoc.Label == "" {
+		return rule, fmt.Errorf("label is required")
+	}
+
+	action := strings.ToUpper(ruleDoc.Action)
+	if !slices.Contains(validActions, action) {
+		return rule, fmt.Errorf("invalid action %q, expected one of %s", ruleDoc.Action, strings.Join(validActions, ", "))
+	}
+
+	protocol := linodego.NetworkProtocol(strings.ToUpper(ruleDoc.Protocol))
+	if !slices.Contains(validProtocols, protocol) {
+		return rule, fmt.Errorf("invalid protocol %q", ruleDoc.Protocol)
+	}
+
+	ports, err := expandPorts(ruleDoc.Ports)
+	if err != nil {
+		return rule, err
+	}
+
+	if ports != "" && protocol != linodego.TCP && protocol != linodego.UDP {
+		return rule, fmt.Errorf("ports can only be specified for TCP and UDP rules")
+	}
+
+	if err := validateAddresses(ruleDoc.IPv4, ruleDoc.IPv6); err != nil {
+		return rule, err
+	}
+
+	ipv4, ipv6 := ruleDoc.IPv4, ruleDoc.IPv6
+
+	for _, name := range ruleDoc.AddressGroups {
+		group, ok := doc.AddressGroups[name]
+		if !ok {
+			return rule, fmt.Errorf("unknown address group %q", name)
+		}
+
+		ipv4 = append(ipv4, group.IPv4...)
+		ipv6 = append(ipv6, group.IPv6...)
+	}
+
+	rule.Label = ruleDoc.Label
+	rule.Action = action
+	rule.Protocol = protocol
+	rule.Ports = ports
+
+	// Addresses are validated above, so they can't fail to be normalized
+	if len(ipv4) > 0 {
+		normalized, _ := helper.UniqueCIDRs(ipv4)
+		rule.Addresses.IPv4 = &normalized
+	}
+
+	if len(ipv6) > 0 {
+		normalized, _ := helper.UniqueCIDRs(ipv6)
+		rule.Addresses.IPv6 = &normalized
+	}
+
+	return rule, nil
+}
+
+// expandPorts validates the given ports and port ranges and
+// joins them into the representation used by the API.
+func expandPorts(specs []portSpec) (string, error) {
+	ports := make([]string, len(specs))
+
+	for i, spec := range specs {
+		from, to, isRange := strings.Cut(string(spec), "-")
+		if !isRange {
+			to = from
+		}
+
+		fromPort, err := parsePort(from)
+		if err != nil {
+			return "", err
+		}
+
+		toPort, err := parsePort(to)
+		if err != nil {
+			return "", err
+		}
+
+		if fromPort >= toPort && isRange {
+			return "", fmt.Errorf("invalid port range %q, the start must be lower than the end", spec)
+		}
+
+		ports[i] = string(spec)
+	}
+
+	return strings.Join(ports, ", "), nil
+}
+
+func parsePort(port string) (int, error) {
+	result, err := strconv.Atoi(port)
+	if err != nil || result < 1 || result > 65535 {
+		return 0, fmt.Errorf("invalid port %q, expected a number between 1 and 65535", port)
+	}
+
+	return result, nil
+}
+
+func validateAddresses(ipv4, ipv6 []string) error {
+	for _, address := range ipv4 {
+		normalized, err := helper.NormalizeCIDR(address)
+		if err != nil || !netip.MustParsePrefix(normalized).Addr().Is4() {
+			return fmt.Errorf("invalid IPv4 address or CIDR range %q", address)
+		}
+	}
+
+	for _, address := range ipv6 {
+		normalized, err := helper.NormalizeCIDR(address)
+		if err != nil || netip.MustParsePrefix(normalized).Addr().Is4() {
+			return fmt.Errorf("invalid IPv6 address or CIDR range %q", address)
+		}
+	}
+
+	return nil
+}
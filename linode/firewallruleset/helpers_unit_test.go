@@ -0,0 +1,99 @@
+//go:build unit
+
+package firewallruleset
+
+import (
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+const testRuleSetYAML = `
+address_groups:
+  office:
+    ipv4: ["203.0.113.0/24", "198.51.100.7"]
+    ipv6: ["2001:DB8::/32"]
+inbound_policy: drop
+inbound:
+  - label: allow-web
+    action: accept
+    protocol: tcp
+    ports: [80, 443, "8000-8080"]
+    ipv4: ["0.0.0.0/0"]
+  - label: allow-ssh
+    action: ACCEPT
+    protocol: TCP
+    ports: [22]
+    ipv4: ["10.0.0.1"]
+    address_groups: [office]
+outbound:
+  - label: drop-smtp
+    action: DROP
+    protocol: TCP
+    ports: [25]
+    address_groups: [office]
+`
+
+func TestParseRuleSet_yaml(t *testing.T) {
+	ruleSet, err := parseRuleSet(testRuleSetYAML)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "DROP", ruleSet.InboundPolicy)
+	assert.Equal(t, "", ruleSet.OutboundPolicy)
+
+	assert.Len(t, ruleSet.Inbound, 2)
+	assert.Equal(t, "allow-web", ruleSet.Inbound[0].Label)
+	assert.Equal(t, "ACCEPT", ruleSet.Inbound[0].Action)
+	assert.Equal(t, linodego.TCP, ruleSet.Inbound[0].Protocol)
+	assert.Equal(t, "80, 443, 8000-8080", ruleSet.Inbound[0].Ports)
+	assert.Equal(t, []string{"0.0.0.0/0"}, *ruleSet.Inbound[0].Addresses.IPv4)
+	assert.Nil(t, ruleSet.Inbound[0].Addresses.IPv6)
+
+	assert.Equal(t, []string{"10.0.0.1/32", "203.0.113.0/24", "198.51.100.7/32"}, *ruleSet.Inbound[1].Addresses.IPv4)
+	assert.Equal(t, []string{"2001:db8::/32"}, *ruleSet.Inbound[1].Addresses.IPv6)
+
+	assert.Len(t, ruleSet.Outbound, 1)
+	assert.Equal(t, "25", ruleSet.Outbound[0].Ports)
+	assert.Equal(t, []string{"2001:db8::/32"}, *ruleSet.Outbound[0].Addresses.IPv6)
+}
+
+func TestParseRuleSet_json(t *testing.T) {
+	ruleSet, err := parseRuleSet(`{
+		"outbound_policy": "ACCEPT",
+		"inbound": [
+			{"label": "allow-dns", "action": "ACCEPT", "protocol": "UDP", "ports": ["53"], "ipv6": ["::/0"]}
+		]
+	}`)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "ACCEPT", ruleSet.OutboundPolicy)
+	assert.Len(t, ruleSet.Inbound, 1)
+	assert.Equal(t, "53", ruleSet.Inbound[0].Ports)
+	assert.Equal(t, []string{"::/0"}, *ruleSet.Inbound[0].Addresses.IPv6)
+	assert.Empty(t, ruleSet.Outbound)
+}
+
+func TestParseRuleSet_invalid(t *testing.T) {
+	for document, expectedErr := range map[string]string{
+		`inbound: [{label: a, action: ACCEPT, protocol: TCP, unknown: true}]`:          "unknown field",
+		`inbound: [{action: ACCEPT, protocol: TCP}]`:                                   "label is required",
+		`inbound: [{label: a, action: ALLOW, protocol: TCP}]`:                          `invalid action "ALLOW"`,
+		`inbound: [{label: a, action: ACCEPT, protocol: SCTP}]`:                        `invalid protocol "SCTP"`,
+		`inbound: [{label: a, action: ACCEPT, protocol: TCP, ports: [70000]}]`:         `invalid port "70000"`,
+		`inbound: [{label: a, action: ACCEPT, protocol: TCP, ports: ["90-80"]}]`:       `invalid port range "90-80"`,
+		`inbound: [{label: a, action: ACCEPT, protocol: ICMP, ports: [22]}]`:           "ports can only be specified for TCP and UDP rules",
+		`inbound: [{label: a, action: ACCEPT, protocol: TCP, ipv4: ["::/0"]}]`:         `invalid IPv4 address or CIDR range "::/0"`,
+		`inbound: [{label: a, action: ACCEPT, protocol: TCP, address_groups: [nope]}]`: `unknown address group "nope"`,
+		`address_groups: {bad: {ipv6: ["10.0.0.0/8"]}}`:                                `address group "bad": invalid IPv6 address`,
+		`outbound_policy: ALLOW`: `invalid policy "ALLOW"`,
+		`outbound: [{label: a, action: DROP, protocol: TCP}, {label: a, action: DROP, protocol: UDP}]`: `duplicate outbound rule label "a"`,
+	} {
+		_, err := parseRuleSet(document)
+		assert.ErrorContains(t, err, expectedErr, document)
+	}
+}
@@ -0,0 +1,23 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label string
+}
+
+func DataBasic(t *testing.T, label string) string {
+	return acceptance.ExecuteTemplate(t,
+		"firewall_ruleset_data_basic", TemplateData{
+			Label: label,
+		})
+}
+
+func DataInvalid(t *testing.T) string {
+	return acceptance.ExecuteTemplate(t,
+		"firewall_ruleset_data_invalid", nil)
+}
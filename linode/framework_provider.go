@@ -14,7 +14,9 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/accountlogins"
 	"github.com/linode/terraform-provider-linode/v2/linode/accountsettings"
 	"github.com/linode/terraform-provider-linode/v2/linode/backup"
+	"github.com/linode/terraform-provider-linode/v2/linode/databasebackup"
 	"github.com/linode/terraform-provider-linode/v2/linode/databasebackups"
+	"github.com/linode/terraform-provider-linode/v2/linode/databasecredentialsrotation"
 	"github.com/linode/terraform-provider-linode/v2/linode/databaseengines"
 	"github.com/linode/terraform-provider-linode/v2/linode/databasemysql"
 	"github.com/linode/terraform-provider-linode/v2/linode/databasepostgresql"
@@ -25,6 +27,7 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/domainzonefile"
 	"github.com/linode/terraform-provider-linode/v2/linode/firewall"
 	"github.com/linode/terraform-provider-linode/v2/linode/firewalldevice"
+	"github.com/linode/terraform-provider-linode/v2/linode/firewallruleset"
 	"github.com/linode/terraform-provider-linode/v2/linode/firewalls"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"github.com/linode/terraform-provider-linode/v2/linode/image"
@@ -41,6 +44,7 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/kernels"
 	"github.com/linode/terraform-provider-linode/v2/linode/lke"
 	"github.com/linode/terraform-provider-linode/v2/linode/lkeclusters"
+	"github.com/linode/terraform-provider-linode/v2/linode/lkenode"
 	"github.com/linode/terraform-provider-linode/v2/linode/lkenodepool"
 	"github.com/linode/terraform-provider-linode/v2/linode/lkeversions"
 	"github.com/linode/terraform-provider-linode/v2/linode/nb"
@@ -48,10 +52,14 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/nbconfigs"
 	"github.com/linode/terraform-provider-linode/v2/linode/nbnode"
 	"github.com/linode/terraform-provider-linode/v2/linode/nbs"
+	"github.com/linode/terraform-provider-linode/v2/linode/nbstats"
 	"github.com/linode/terraform-provider-linode/v2/linode/networkingip"
+	"github.com/linode/terraform-provider-linode/v2/linode/obj"
 	"github.com/linode/terraform-provider-linode/v2/linode/objbucket"
 	"github.com/linode/terraform-provider-linode/v2/linode/objcluster"
 	"github.com/linode/terraform-provider-linode/v2/linode/objkey"
+	"github.com/linode/terraform-provider-linode/v2/linode/objpresignedurl"
+	"github.com/linode/terraform-provider-linode/v2/linode/objs"
 	"github.com/linode/terraform-provider-linode/v2/linode/profile"
 	"github.com/linode/terraform-provider-linode/v2/linode/rdns"
 	"github.com/linode/terraform-provider-linode/v2/linode/region"
@@ -82,8 +90,9 @@ func CreateFrameworkProviderWithMeta(version string, meta *helper.ProviderMeta)
 	return &FrameworkProvider{
 		ProviderVersion: version,
 		Meta: &helper.FrameworkProviderMeta{
-			Client: &meta.Client,
-			Config: helper.GetFrameworkProviderModelFromSDKv2ProviderConfig(meta.Config),
+			Client:      &meta.Client,
+			Config:      helper.GetFrameworkProviderModelFromSDKv2ProviderConfig(meta.Config),
+			ObjTempKeys: meta.ObjTempKeys,
 		},
 	}
 }
@@ -143,6 +152,11 @@ func (p *FrameworkProvider) Schema(
 				Optional:    true,
 				Description: "If true, Linode Instances will not be rebooted on config and interface changes.",
 			},
+			"skip_instance_firewall_check": schema.BoolAttribute{
+				Optional: true,
+				Description: "Skip checking whether a linode_instance resource is still attached " +
+					"to the firewall it was created with.",
+			},
 			"disable_internal_cache": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Disable the internal caching system that backs certain Linode API requests.",
@@ -181,6 +195,15 @@ func (p *FrameworkProvider) Schema(
 				Description: "If true, temporary object keys will be created implicitly at apply-time " +
 					"for the linode_object_storage_object and linode_object_sorage_bucket resource.",
 			},
+			"obj_endpoint_override": schema.StringAttribute{
+				Optional: true,
+				Description: "The S3 endpoint used for object storage operations instead of the one computed " +
+					"from the cluster, e.g. a local S3-compatible server for testing.",
+			},
+			"obj_use_path_style": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, buckets are addressed in the request path instead of the endpoint hostname.",
+			},
 		},
 	}
 }
@@ -194,6 +217,8 @@ func (p *FrameworkProvider) Resources(ctx context.Context) []func() resource.Res
 		sshkey.NewResource,
 		ipv6range.NewResource,
 		nb.NewResource,
+		nbconfig.NewResource,
+		nbnode.NewResource,
 		accountsettings.NewResource,
 		vpcsubnet.NewResource,
 		vpc.NewResource,
@@ -204,6 +229,8 @@ func (p *FrameworkProvider) Resources(ctx context.Context) []func() resource.Res
 		instancedisk.NewResource,
 		lkenodepool.NewResource,
 		image.NewResource,
+		databasecredentialsrotation.NewResource,
+		databasebackup.NewResource,
 	}
 }
 
@@ -213,6 +240,7 @@ func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource
 		account.NewDataSource,
 		backup.NewDataSource,
 		firewall.NewDataSource,
+		firewallruleset.NewDataSource,
 		kernel.NewDataSource,
 		stackscript.NewDataSource,
 		stackscripts.NewDataSource,
@@ -223,6 +251,9 @@ func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource
 		regions.NewDataSource,
 		ipv6range.NewDataSource,
 		objbucket.NewDataSource,
+		obj.NewDataSource,
+		objs.NewDataSource,
+		objpresignedurl.NewDataSource,
 		sshkey.NewDataSource,
 		sshkeys.NewDataSource,
 		instancenetworking.NewDataSource,
@@ -249,6 +280,7 @@ func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource
 		users.NewDataSource,
 		nbnode.NewDataSource,
 		nbs.NewDataSource,
+		nbstats.NewDataSource,
 		accountsettings.NewDataSource,
 		firewalls.NewDataSource,
 		kernels.NewDataSource,
@@ -263,5 +295,7 @@ func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource
 		domains.NewDataSource,
 		lke.NewDataSource,
 		lkeclusters.NewDataSource,
+		lkenodepool.NewDataSource,
+		lkenode.NewDataSource,
 	}
 }
@@ -104,6 +104,34 @@ func GetIntFromEnv(
 	return types.Int64Value(intVal)
 }
 
+func GetBoolFromEnv(
+	key string,
+	defaultValue basetypes.BoolValue,
+	diags *diag.Diagnostics,
+) basetypes.BoolValue {
+	envVarVal := os.Getenv(key)
+	if envVarVal == "" {
+		return defaultValue
+	}
+
+	boolVal, err := strconv.ParseBool(envVarVal)
+	if err != nil {
+		diags.AddWarning(
+			fmt.Sprintf(
+				"Failed to parse the environment variable %v "+
+					"to a boolean. Will use default value: %v instead",
+				key,
+				defaultValue.ValueBool(),
+			),
+			err.Error(),
+		)
+
+		return defaultValue
+	}
+
+	return types.BoolValue(boolVal)
+}
+
 func GetStringFromEnv(key string, defaultValue basetypes.StringValue) basetypes.StringValue {
 	envVarVal := os.Getenv(key)
 
@@ -169,6 +197,10 @@ func (fp *FrameworkProvider) HandleDefaults(
 		lpm.SkipImplicitReboots = types.BoolValue(false)
 	}
 
+	if lpm.SkipInstanceFirewallCheck.IsNull() {
+		lpm.SkipInstanceFirewallCheck = types.BoolValue(false)
+	}
+
 	if lpm.DisableInternalCache.IsNull() {
 		lpm.DisableInternalCache = types.BoolValue(false)
 	}
@@ -206,6 +238,21 @@ func (fp *FrameworkProvider) HandleDefaults(
 	if lpm.ObjUseTempKeys.IsNull() {
 		lpm.ObjUseTempKeys = types.BoolValue(false)
 	}
+
+	if lpm.ObjEndpointOverride.IsNull() {
+		lpm.ObjEndpointOverride = GetStringFromEnv(
+			"LINODE_OBJ_ENDPOINT_OVERRIDE",
+			types.StringNull(),
+		)
+	}
+
+	if lpm.ObjUsePathStyle.IsNull() {
+		lpm.ObjUsePathStyle = GetBoolFromEnv(
+			"LINODE_OBJ_USE_PATH_STYLE",
+			types.BoolValue(false),
+			diags,
+		)
+	}
 }
 
 func (fp *FrameworkProvider) InitProvider(
@@ -297,6 +344,19 @@ func (fp *FrameworkProvider) InitProvider(
 
 	meta.Config = lpm
 	meta.Client = &client
+
+	if lpm.ObjUseTempKeys.ValueBool() {
+		if lpm.ObjEndpointOverride.ValueString() != "" {
+			diags.AddError(
+				"Invalid Object Storage Configuration",
+				"obj_use_temp_keys can't be used with obj_endpoint_override "+
+					"since temporary keys are created through the Linode API",
+			)
+			return
+		}
+
+		meta.ObjTempKeys = helper.SharedObjTempKeyBroker(client, accessToken, APIURL, helper.ObjTempKeyTTL)
+	}
 }
 
 func (fp *FrameworkProvider) terraformUserAgent(
@@ -23,6 +23,9 @@ const DefaultLinodeURL = "https://api.linode.com"
 type ProviderMeta struct {
 	Client linodego.Client
 	Config *Config
+
+	// ObjTempKeys is set when obj_use_temp_keys is enabled.
+	ObjTempKeys *ObjTempKeyBroker
 }
 
 // Config represents the Linode provider configuration.
@@ -40,6 +43,7 @@ type Config struct {
 	SkipInstanceReadyPoll        bool
 	SkipInstanceDeletePoll       bool
 	SkipImplicitReboots          bool
+	SkipInstanceFirewallCheck    bool
 	DisableInternalCache         bool
 	MinRetryDelayMilliseconds    int
 	MaxRetryDelayMilliseconds    int
@@ -47,9 +51,11 @@ type Config struct {
 	LKEEventPollMilliseconds     int
 	LKENodeReadyPollMilliseconds int
 
-	ObjAccessKey   string
-	ObjSecretKey   string
-	ObjUseTempKeys bool
+	ObjAccessKey        string
+	ObjSecretKey        string
+	ObjUseTempKeys      bool
+	ObjEndpointOverride string
+	ObjUsePathStyle     bool
 }
 
 // Client returns a fully initialized Linode client.
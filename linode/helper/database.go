@@ -175,3 +175,58 @@ func FlattenDatabaseMaintenanceWindow(ctx context.Context, maintenance linodego.
 
 	return &resultList, nil
 }
+
+// DatabaseFork is the source of a Managed Database provisioned from a backup or a
+// point in time of another Managed Database.
+// NOTE: This is not yet supported by linodego.
+type DatabaseFork struct {
+	Source      int    `json:"source"`
+	RestoreTime string `json:"restore_time,omitempty"`
+}
+
+// ExpandDatabaseFork expands a restore_from block of a database resource. A backup
+// is restored from the point in time it was created at, so backups outside the
+// point-in-time restore window of the source database can't be restored.
+func ExpandDatabaseFork(
+	ctx context.Context, client linodego.Client, engine linodego.DatabaseEngineType, restoreFrom map[string]any,
+) (*DatabaseFork, error) {
+	result := DatabaseFork{
+		Source: restoreFrom["database_id"].(int),
+	}
+
+	if backupID := restoreFrom["backup_id"].(int); backupID != 0 {
+		var created *time.Time
+
+		switch engine {
+		case linodego.DatabaseEngineTypeMySQL:
+			backup, err := client.GetMySQLDatabaseBackup(ctx, result.Source, backupID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get backup %d of database %d: %w", backupID, result.Source, err)
+			}
+			created = backup.Created
+		case linodego.DatabaseEngineTypePostgres:
+			backup, err := client.GetPostgresDatabaseBackup(ctx, result.Source, backupID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get backup %d of database %d: %w", backupID, result.Source, err)
+			}
+			created = backup.Created
+		default:
+			return nil, fmt.Errorf("invalid database engine: %s", engine)
+		}
+
+		if created == nil {
+			return nil, fmt.Errorf("backup %d of database %d has no creation time", backupID, result.Source)
+		}
+
+		result.RestoreTime = created.UTC().Format(time.RFC3339)
+		return &result, nil
+	}
+
+	restoreTime, err := time.Parse(time.RFC3339, restoreFrom["restore_time"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse restore_time: %w", err)
+	}
+
+	result.RestoreTime = restoreTime.UTC().Format(time.RFC3339)
+	return &result, nil
+}
@@ -0,0 +1,69 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandDatabaseFork(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch strings.TrimPrefix(r.URL.Path, "/v4") {
+		case "/databases/mysql/instances/10/backups/20":
+			_, _ = w.Write([]byte(`{"id": 20, "label": "snapshot", "type": "snapshot", "created": "2024-03-01T12:30:00"}`))
+		case "/databases/postgresql/instances/10/backups/20":
+			_, _ = w.Write([]byte(`{"id": 20, "label": "auto", "type": "auto", "created": "2024-03-02T08:00:00"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	ctx := context.Background()
+
+	fork, err := helper.ExpandDatabaseFork(ctx, client, linodego.DatabaseEngineTypeMySQL, map[string]any{
+		"database_id":  10,
+		"backup_id":    20,
+		"restore_time": "",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, helper.DatabaseFork{Source: 10, RestoreTime: "2024-03-01T12:30:00Z"}, *fork)
+
+	fork, err = helper.ExpandDatabaseFork(ctx, client, linodego.DatabaseEngineTypePostgres, map[string]any{
+		"database_id":  10,
+		"backup_id":    20,
+		"restore_time": "",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, helper.DatabaseFork{Source: 10, RestoreTime: "2024-03-02T08:00:00Z"}, *fork)
+
+	fork, err = helper.ExpandDatabaseFork(ctx, client, linodego.DatabaseEngineTypeMySQL, map[string]any{
+		"database_id":  10,
+		"backup_id":    0,
+		"restore_time": "2024-03-01T14:00:00+02:00",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, helper.DatabaseFork{Source: 10, RestoreTime: "2024-03-01T12:00:00Z"}, *fork)
+
+	_, err = helper.ExpandDatabaseFork(ctx, client, linodego.DatabaseEngineTypeMySQL, map[string]any{
+		"database_id":  10,
+		"backup_id":    21,
+		"restore_time": "",
+	})
+	assert.ErrorContains(t, err, "failed to get backup 21 of database 10")
+}
@@ -17,6 +17,7 @@ func GetFrameworkProviderModelFromSDKv2ProviderConfig(config *Config) *Framework
 		SkipInstanceReadyPoll:        types.BoolValue(config.SkipInstanceReadyPoll),
 		SkipInstanceDeletePoll:       types.BoolValue(config.SkipInstanceDeletePoll),
 		SkipImplicitReboots:          types.BoolValue(config.SkipImplicitReboots),
+		SkipInstanceFirewallCheck:    types.BoolValue(config.SkipInstanceFirewallCheck),
 		DisableInternalCache:         types.BoolValue(config.DisableInternalCache),
 		MinRetryDelayMilliseconds:    types.Int64Value(int64(config.MinRetryDelayMilliseconds)),
 		MaxRetryDelayMilliseconds:    types.Int64Value(int64(config.MaxRetryDelayMilliseconds)),
@@ -26,6 +27,8 @@ func GetFrameworkProviderModelFromSDKv2ProviderConfig(config *Config) *Framework
 		ObjAccessKey:                 types.StringValue(config.ObjAccessKey),
 		ObjSecretKey:                 types.StringValue(config.ObjSecretKey),
 		ObjUseTempKeys:               types.BoolValue(config.ObjUseTempKeys),
+		ObjEndpointOverride:          types.StringValue(config.ObjEndpointOverride),
+		ObjUsePathStyle:              types.BoolValue(config.ObjUsePathStyle),
 	}
 }
 
@@ -43,6 +46,8 @@ type FrameworkProviderModel struct {
 
 	SkipImplicitReboots types.Bool `tfsdk:"skip_implicit_reboots"`
 
+	SkipInstanceFirewallCheck types.Bool `tfsdk:"skip_instance_firewall_check"`
+
 	DisableInternalCache types.Bool `tfsdk:"disable_internal_cache"`
 
 	MinRetryDelayMilliseconds types.Int64 `tfsdk:"min_retry_delay_ms"`
@@ -53,12 +58,17 @@ type FrameworkProviderModel struct {
 
 	LKENodeReadyPollMilliseconds types.Int64 `tfsdk:"lke_node_ready_poll_ms"`
 
-	ObjAccessKey   types.String `tfsdk:"obj_access_key"`
-	ObjSecretKey   types.String `tfsdk:"obj_secret_key"`
-	ObjUseTempKeys types.Bool   `tfsdk:"obj_use_temp_keys"`
+	ObjAccessKey        types.String `tfsdk:"obj_access_key"`
+	ObjSecretKey        types.String `tfsdk:"obj_secret_key"`
+	ObjUseTempKeys      types.Bool   `tfsdk:"obj_use_temp_keys"`
+	ObjEndpointOverride types.String `tfsdk:"obj_endpoint_override"`
+	ObjUsePathStyle     types.Bool   `tfsdk:"obj_use_path_style"`
 }
 
 type FrameworkProviderMeta struct {
 	Client *linodego.Client
 	Config *FrameworkProviderModel
+
+	// ObjTempKeys is set when obj_use_temp_keys is enabled.
+	ObjTempKeys *ObjTempKeyBroker
 }
@@ -0,0 +1,97 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/linode/linodego"
+)
+
+const NodeBalancerNodeStatusUp = "UP"
+
+// NodeBalancerHealthPollInterval is the interval at which the health of
+// NodeBalancer backends is polled.
+var NodeBalancerHealthPollInterval = 5 * time.Second
+
+// WaitForNodeBalancerNodeHealthy waits until the health checks of the given
+// NodeBalancer node report it as UP.
+func WaitForNodeBalancerNodeHealthy(
+	ctx context.Context,
+	client linodego.Client,
+	nodeBalancerID, configID, nodeID int,
+	timeout time.Duration,
+) (*linodego.NodeBalancerNode, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(NodeBalancerHealthPollInterval)
+	defer ticker.Stop()
+
+	status := "unknown"
+
+	for {
+		node, err := client.GetNodeBalancerNode(ctx, nodeBalancerID, configID, nodeID)
+		if err != nil && ctx.Err() == nil {
+			return nil, fmt.Errorf("failed to get nodebalancer %d config %d node %d: %w",
+				nodeBalancerID, configID, nodeID, err)
+		}
+
+		if node != nil {
+			if node.Status == NodeBalancerNodeStatusUp {
+				return node, nil
+			}
+			status = node.Status
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, fmt.Errorf(
+				"timed out waiting for nodebalancer %d config %d node %d to become healthy (status: %s)",
+				nodeBalancerID, configID, nodeID, status,
+			)
+		}
+	}
+}
+
+// WaitForNodeBalancerConfigHealthy waits until at least minUp nodes of the given
+// NodeBalancer config are reported as UP by its health checks.
+func WaitForNodeBalancerConfigHealthy(
+	ctx context.Context,
+	client linodego.Client,
+	nodeBalancerID, configID, minUp int,
+	timeout time.Duration,
+) (*linodego.NodeBalancerConfig, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(NodeBalancerHealthPollInterval)
+	defer ticker.Stop()
+
+	var up, down int
+
+	for {
+		config, err := client.GetNodeBalancerConfig(ctx, nodeBalancerID, configID)
+		if err != nil && ctx.Err() == nil {
+			return nil, fmt.Errorf("failed to get nodebalancer %d config %d: %w", nodeBalancerID, configID, err)
+		}
+
+		if config != nil && config.NodesStatus != nil {
+			up, down = config.NodesStatus.Up, config.NodesStatus.Down
+
+			if up >= minUp {
+				return config, nil
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, fmt.Errorf(
+				"timed out waiting for %d healthy nodes on nodebalancer %d config %d (up: %d, down: %d)",
+				minUp, nodeBalancerID, configID, up, down,
+			)
+		}
+	}
+}
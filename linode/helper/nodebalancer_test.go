@@ -0,0 +1,93 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func newNodeBalancerTestClient(t *testing.T, handler http.HandlerFunc) linodego.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	return client
+}
+
+func TestWaitForNodeBalancerNodeHealthy(t *testing.T) {
+	helper.NodeBalancerHealthPollInterval = 10 * time.Millisecond
+
+	var requests atomic.Int32
+
+	client := newNodeBalancerTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if strings.TrimPrefix(r.URL.Path, "/v4") != "/nodebalancers/1/configs/2/nodes/3" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+			return
+		}
+
+		status := "unknown"
+		if requests.Add(1) >= 3 {
+			status = "UP"
+		}
+
+		_, _ = fmt.Fprintf(w, `{"id": 3, "config_id": 2, "nodebalancer_id": 1, "status": %q}`, status)
+	})
+
+	node, err := helper.WaitForNodeBalancerNodeHealthy(context.Background(), client, 1, 2, 3, time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, "UP", node.Status)
+	assert.Equal(t, int32(3), requests.Load())
+
+	_, err = helper.WaitForNodeBalancerNodeHealthy(context.Background(), client, 1, 2, 4, time.Second)
+	assert.ErrorContains(t, err, "failed to get nodebalancer 1 config 2 node 4")
+}
+
+func TestWaitForNodeBalancerNodeHealthyTimeout(t *testing.T) {
+	helper.NodeBalancerHealthPollInterval = 10 * time.Millisecond
+
+	client := newNodeBalancerTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 3, "status": "DOWN"}`))
+	})
+
+	_, err := helper.WaitForNodeBalancerNodeHealthy(context.Background(), client, 1, 2, 3, 50*time.Millisecond)
+	assert.ErrorContains(t, err, "timed out waiting for nodebalancer 1 config 2 node 3 to become healthy (status: DOWN)")
+}
+
+func TestWaitForNodeBalancerConfigHealthy(t *testing.T) {
+	helper.NodeBalancerHealthPollInterval = 10 * time.Millisecond
+
+	var requests atomic.Int32
+
+	client := newNodeBalancerTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		up := min(requests.Add(1), 2)
+		_, _ = fmt.Fprintf(w, `{"id": 2, "nodebalancer_id": 1, "nodes_status": {"up": %d, "down": %d}}`, up, 3-up)
+	})
+
+	config, err := helper.WaitForNodeBalancerConfigHealthy(context.Background(), client, 1, 2, 2, time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, config.NodesStatus.Up)
+
+	_, err = helper.WaitForNodeBalancerConfigHealthy(context.Background(), client, 1, 2, 5, 50*time.Millisecond)
+	assert.ErrorContains(t, err, "timed out waiting for 5 healthy nodes on nodebalancer 1 config 2")
+}
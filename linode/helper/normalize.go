@@ -1,7 +1,11 @@
 package helper
 
 import (
+	"encoding/json"
 	"net"
+	"net/netip"
+	"sort"
+	"strings"
 )
 
 func CompareIPv6Ranges(i, v string) (bool, error) {
@@ -17,3 +21,190 @@ func CompareIPv6Ranges(i, v string) (bool, error) {
 
 	return ipi.Equal(ipv) && ipneti.Mask.String() == ipnetv.Mask.String(), nil
 }
+
+// NormalizeCIDR converts the given IP address or CIDR range into its
+// canonical CIDR form, e.g. `10.0.0.1` -> `10.0.0.1/32` and
+// `2001:DB8:0::/32` -> `2001:db8::/32`.
+func NormalizeCIDR(address string) (string, error) {
+	if !strings.Contains(address, "/") {
+		addr, err := netip.ParseAddr(address)
+		if err != nil {
+			return "", err
+		}
+
+		return netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()).String(), nil
+	}
+
+	prefix, err := netip.ParsePrefix(address)
+	if err != nil {
+		return "", err
+	}
+
+	return prefix.Masked().String(), nil
+}
+
+// CompareCIDRs returns whether the given addresses or CIDR ranges
+// are equal after being normalized using NormalizeCIDR.
+func CompareCIDRs(a, b string) bool {
+	normalizedA, err := NormalizeCIDR(a)
+	if err != nil {
+		return false
+	}
+
+	normalizedB, err := NormalizeCIDR(b)
+	if err != nil {
+		return false
+	}
+
+	return normalizedA == normalizedB
+}
+
+// NormalizeCIDRs normalizes each of the given addresses using NormalizeCIDR.
+func NormalizeCIDRs(addresses []string) ([]string, error) {
+	result := make([]string, len(addresses))
+
+	for i, address := range addresses {
+		normalized, err := NormalizeCIDR(address)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = normalized
+	}
+
+	return result, nil
+}
+
+// UniqueCIDRs normalizes the given addresses using NormalizeCIDR and
+// drops duplicates, preserving the order of the remaining addresses.
+func UniqueCIDRs(addresses []string) ([]string, error) {
+	normalized, err := NormalizeCIDRs(addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(normalized))
+	result := make([]string, 0, len(normalized))
+
+	for _, address := range normalized {
+		if seen[address] {
+			continue
+		}
+
+		seen[address] = true
+		result = append(result, address)
+	}
+
+	return result, nil
+}
+
+// AggregateCIDRs returns the smallest list of CIDR ranges covering the same
+// addresses as the given addresses, e.g. `10.0.0.0/25` and `10.0.0.128/25`
+// are merged into `10.0.0.0/24`. Ranges contained in other ranges are dropped.
+func AggregateCIDRs(addresses []string) ([]string, error) {
+	prefixes := make([]netip.Prefix, len(addresses))
+
+	for i, address := range addresses {
+		normalized, err := NormalizeCIDR(address)
+		if err != nil {
+			return nil, err
+		}
+
+		prefixes[i] = netip.MustParsePrefix(normalized)
+	}
+
+	sort.Slice(prefixes, func(i, j int) bool {
+		if c := prefixes[i].Addr().Compare(prefixes[j].Addr()); c != 0 {
+			return c < 0
+		}
+
+		return prefixes[i].Bits() < prefixes[j].Bits()
+	})
+
+	aggregated := make([]netip.Prefix, 0, len(prefixes))
+
+	for _, prefix := range prefixes {
+		if n := len(aggregated); n > 0 && aggregated[n-1].Contains(prefix.Addr()) &&
+			aggregated[n-1].Bits() <= prefix.Bits() {
+			continue
+		}
+
+		aggregated = append(aggregated, prefix)
+
+		// Merge the last two ranges for as long as they're the two halves of a larger range
+		for n := len(aggregated); n > 1 && cidrSiblings(aggregated[n-2], aggregated[n-1]); n-- {
+			aggregated = append(
+				aggregated[:n-2],
+				netip.PrefixFrom(aggregated[n-2].Addr(), aggregated[n-2].Bits()-1),
+			)
+		}
+	}
+
+	result := make([]string, len(aggregated))
+	for i, prefix := range aggregated {
+		result[i] = prefix.String()
+	}
+
+	return result, nil
+}
+
+// cidrSiblings returns whether the given ranges are the lower
+// and upper halves of the same larger range.
+func cidrSiblings(lower, upper netip.Prefix) bool {
+	if lower.Bits() != upper.Bits() || lower.Bits() == 0 || lower.Addr().Is4() != upper.Addr().Is4() {
+		return false
+	}
+
+	parent := netip.PrefixFrom(lower.Addr(), lower.Bits()-1)
+
+	return parent.Masked() == parent && lower != upper && parent.Contains(upper.Addr())
+}
+
+// CIDRListsEquivalent returns whether the given lists contain the same
+// CIDR ranges regardless of formatting and order.
+func CIDRListsEquivalent(a, b []string) bool {
+	normalizedA, err := NormalizeCIDRs(a)
+	if err != nil {
+		return false
+	}
+
+	normalizedB, err := NormalizeCIDRs(b)
+	if err != nil {
+		return false
+	}
+
+	return StringListElementsEqual(normalizedA, normalizedB)
+}
+
+// NormalizeJSON re-encodes the given JSON document in a compact form
+// with sorted object keys.
+func NormalizeJSON(document string) (string, error) {
+	var value any
+
+	if err := json.Unmarshal([]byte(document), &value); err != nil {
+		return "", err
+	}
+
+	result, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
+// JSONStringsEquivalent returns whether the given JSON documents are
+// semantically equal regardless of formatting and key order.
+func JSONStringsEquivalent(a, b string) bool {
+	normalizedA, err := NormalizeJSON(a)
+	if err != nil {
+		return false
+	}
+
+	normalizedB, err := NormalizeJSON(b)
+	if err != nil {
+		return false
+	}
+
+	return normalizedA == normalizedB
+}
@@ -3,6 +3,7 @@
 package helper_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
@@ -43,3 +44,130 @@ func TestCompareIPv6Ranges(t *testing.T) {
 		t.Fatalf("ranges are reported as equal despite having different masks")
 	}
 }
+
+func TestNormalizeCIDR(t *testing.T) {
+	for input, expected := range map[string]string{
+		"10.0.0.1":            "10.0.0.1/32",
+		"10.0.0.1/32":         "10.0.0.1/32",
+		"10.0.0.5/24":         "10.0.0.0/24",
+		"2001:DB8:0::1":       "2001:db8::1/128",
+		"2001:0db8:0000::/32": "2001:db8::/32",
+	} {
+		result, err := helper.NormalizeCIDR(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if result != expected {
+			t.Errorf("expected %s to normalize to %s, got %s", input, expected, result)
+		}
+	}
+
+	if _, err := helper.NormalizeCIDR("not-an-ip"); err == nil {
+		t.Errorf("expected error for invalid address")
+	}
+}
+
+func TestCIDRListsEquivalent(t *testing.T) {
+	if !helper.CIDRListsEquivalent(
+		[]string{"10.0.0.1", "2001:DB8::/32"},
+		[]string{"2001:db8::/32", "10.0.0.1/32"},
+	) {
+		t.Errorf("expected lists to be equivalent")
+	}
+
+	if helper.CIDRListsEquivalent(
+		[]string{"10.0.0.1"},
+		[]string{"10.0.0.2/32"},
+	) {
+		t.Errorf("expected lists to not be equivalent")
+	}
+}
+
+func TestNormalizeJSON(t *testing.T) {
+	result, err := helper.NormalizeJSON("{\n  \"b\": [1, 2],\n  \"a\": {\"d\": true, \"c\": null}\n}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `{"a":{"c":null,"d":true},"b":[1,2]}`; result != expected {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+
+	if _, err := helper.NormalizeJSON("{invalid"); err == nil {
+		t.Errorf("expected error for invalid JSON")
+	}
+}
+
+func TestJSONStringsEquivalent(t *testing.T) {
+	if !helper.JSONStringsEquivalent(`{"a": 1, "b": "c"}`, `{"b":"c","a":1}`) {
+		t.Errorf("expected documents to be equivalent")
+	}
+
+	if helper.JSONStringsEquivalent(`{"a": [1, 2]}`, `{"a": [2, 1]}`) {
+		t.Errorf("expected documents to not be equivalent")
+	}
+}
+
+func TestUniqueCIDRs(t *testing.T) {
+	result, err := helper.UniqueCIDRs([]string{"10.0.0.1", "192.168.0.0/16", "10.0.0.1/32"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(result, []string{"10.0.0.1/32", "192.168.0.0/16"}) {
+		t.Errorf("unexpected unique addresses: %v", result)
+	}
+}
+
+func TestAggregateCIDRs(t *testing.T) {
+	for _, tc := range []struct {
+		input    []string
+		expected []string
+	}{
+		{
+			input:    []string{"10.0.0.128/25", "10.0.0.0/25"},
+			expected: []string{"10.0.0.0/24"},
+		},
+		{
+			input:    []string{"10.0.0.0", "10.0.0.1", "10.0.0.2/31", "10.0.0.5"},
+			expected: []string{"10.0.0.0/30", "10.0.0.5/32"},
+		},
+		{
+			input:    []string{"10.0.0.0/8", "10.1.2.3", "192.168.1.0/24", "192.168.0.0/24"},
+			expected: []string{"10.0.0.0/8", "192.168.0.0/23"},
+		},
+		{
+			// Adjacent ranges which don't form a larger range aren't merged
+			input:    []string{"10.0.1.0/24", "10.0.2.0/24"},
+			expected: []string{"10.0.1.0/24", "10.0.2.0/24"},
+		},
+		{
+			input:    []string{"2001:db8::1", "2001:DB8::", "2001:db8:1::/48"},
+			expected: []string{"2001:db8::/127", "2001:db8:1::/48"},
+		},
+	} {
+		result, err := helper.AggregateCIDRs(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(result, tc.expected) {
+			t.Errorf("expected %v to aggregate to %v, got %v", tc.input, tc.expected, result)
+		}
+	}
+
+	if _, err := helper.AggregateCIDRs([]string{"not-an-ip"}); err == nil {
+		t.Errorf("expected error for invalid address")
+	}
+}
+
+func TestCompareCIDRs(t *testing.T) {
+	if !helper.CompareCIDRs("2001:DB8:0::1", "2001:db8::1/128") {
+		t.Errorf("expected addresses to be equal")
+	}
+
+	if helper.CompareCIDRs("10.0.0.1", "10.0.0.1/31") {
+		t.Errorf("expected addresses to not be equal")
+	}
+}
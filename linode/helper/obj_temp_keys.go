@@ -0,0 +1,304 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+)
+
+// ObjTempKeyTTL is how long a temporary Object Storage Key is handed out
+// for before it expires and a new key is created in its place.
+const ObjTempKeyTTL = time.Hour
+
+// objTempKeyDeleteConcurrency is the maximum number of keys
+// deleted in parallel when the brokers are shut down.
+const objTempKeyDeleteConcurrency = 8
+
+var (
+	objTempKeyBrokers   = make(map[objTempKeyBrokerID]*ObjTempKeyBroker)
+	objTempKeyBrokersMu sync.Mutex
+)
+
+type objTempKeyBrokerID struct {
+	Token  string
+	APIURL string
+}
+
+type objTempKeyScope struct {
+	Bucket      string
+	Cluster     string
+	Permissions string
+}
+
+type objTempKey struct {
+	scope objTempKeyScope
+
+	// ready is closed once the key has been created or failed to be created.
+	ready chan struct{}
+
+	key       *linodego.ObjectStorageKey
+	err       error
+	createdAt time.Time
+	refs      int
+}
+
+// ObjTempKeyBroker shares temporary Object Storage Keys between the
+// resources and data sources of a provider run. One key with limited
+// permissions is created per bucket, cluster and permission, and is kept
+// until the broker is shut down. Keys older than the TTL are replaced for
+// new users and deleted once they aren't in use anymore.
+type ObjTempKeyBroker struct {
+	client linodego.Client
+	ttl    time.Duration
+
+	mu sync.Mutex
+	// keys holds the key currently handed out for each scope.
+	keys map[objTempKeyScope]*objTempKey
+	// live holds all keys which haven't been deleted yet,
+	// including expired keys which are still in use.
+	live map[*objTempKey]struct{}
+}
+
+// NewObjTempKeyBroker creates a broker which must be cleaned up using Shutdown.
+func NewObjTempKeyBroker(client linodego.Client, ttl time.Duration) *ObjTempKeyBroker {
+	return &ObjTempKeyBroker{
+		client: client,
+		ttl:    ttl,
+		keys:   make(map[objTempKeyScope]*objTempKey),
+		live:   make(map[*objTempKey]struct{}),
+	}
+}
+
+// SharedObjTempKeyBroker returns the broker of this process for the given
+// API token and URL, creating it if needed, so that the SDKv2 and framework
+// providers share the same keys. Shared brokers are cleaned up by
+// ShutdownObjTempKeyBrokers.
+func SharedObjTempKeyBroker(
+	client linodego.Client,
+	token, apiURL string,
+	ttl time.Duration,
+) *ObjTempKeyBroker {
+	if apiURL == "" {
+		apiURL = DefaultLinodeURL
+	}
+
+	id := objTempKeyBrokerID{Token: token, APIURL: apiURL}
+
+	objTempKeyBrokersMu.Lock()
+	defer objTempKeyBrokersMu.Unlock()
+
+	if broker, ok := objTempKeyBrokers[id]; ok {
+		return broker
+	}
+
+	broker := NewObjTempKeyBroker(client, ttl)
+	objTempKeyBrokers[id] = broker
+
+	return broker
+}
+
+// ShutdownObjTempKeyBrokers deletes all keys still held by the shared brokers of this process.
+func ShutdownObjTempKeyBrokers(ctx context.Context) {
+	objTempKeyBrokersMu.Lock()
+	brokers := objTempKeyBrokers
+	objTempKeyBrokers = make(map[objTempKeyBrokerID]*ObjTempKeyBroker)
+	objTempKeyBrokersMu.Unlock()
+
+	var wg sync.WaitGroup
+
+	for _, broker := range brokers {
+		wg.Add(1)
+		go func(broker *ObjTempKeyBroker) {
+			defer wg.Done()
+			broker.Shutdown(ctx)
+		}(broker)
+	}
+
+	wg.Wait()
+}
+
+// Acquire returns the temporary key for the given bucket, cluster and permissions,
+// creating it if needed. The returned function must be called once the key isn't
+// used anymore.
+func (b *ObjTempKeyBroker) Acquire(
+	ctx context.Context,
+	bucket, cluster, permissions string,
+) (*linodego.ObjectStorageKey, func(), error) {
+	scope := objTempKeyScope{Bucket: bucket, Cluster: cluster, Permissions: permissions}
+
+	b.mu.Lock()
+
+	var expired *objTempKey
+
+	entry, ok := b.keys[scope]
+	if ok && b.isExpired(entry) {
+		tflog.Debug(ctx, "temporary object storage key expired, creating a new one", map[string]any{
+			"key_id": entry.key.ID,
+		})
+		delete(b.keys, scope)
+
+		// An expired key still in use is deleted once its last user releases it
+		if entry.refs == 0 {
+			expired = entry
+			delete(b.live, entry)
+		}
+		ok = false
+	}
+
+	if !ok {
+		entry = &objTempKey{scope: scope, ready: make(chan struct{})}
+		b.keys[scope] = entry
+		b.live[entry] = struct{}{}
+	}
+
+	entry.refs++
+	b.mu.Unlock()
+
+	if expired != nil {
+		deleteObjTempKey(ctx, b.client, expired.key.ID)
+	}
+
+	if !ok {
+		key, err := createObjTempKey(ctx, b.client, scope)
+
+		b.mu.Lock()
+		entry.key, entry.err, entry.createdAt = key, err, time.Now()
+		if err != nil && b.keys[scope] == entry {
+			// Let the next caller retry creating the key
+			delete(b.keys, scope)
+		}
+		close(entry.ready)
+		b.mu.Unlock()
+	}
+
+	select {
+	case <-entry.ready:
+	case <-ctx.Done():
+		b.release(ctx, entry)
+		return nil, nil, ctx.Err()
+	}
+
+	if entry.err != nil {
+		b.release(ctx, entry)
+		return nil, nil, entry.err
+	}
+
+	var once sync.Once
+
+	return entry.key, func() { once.Do(func() { b.release(ctx, entry) }) }, nil
+}
+
+// Shutdown deletes all keys still held by the broker.
+func (b *ObjTempKeyBroker) Shutdown(ctx context.Context) {
+	b.mu.Lock()
+
+	entries := make([]*objTempKey, 0, len(b.live))
+	for entry := range b.live {
+		entries = append(entries, entry)
+	}
+
+	b.keys = make(map[objTempKeyScope]*objTempKey)
+	b.live = make(map[*objTempKey]struct{})
+
+	b.mu.Unlock()
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, objTempKeyDeleteConcurrency)
+
+	for _, entry := range entries {
+		wg.Add(1)
+		go func(entry *objTempKey) {
+			defer wg.Done()
+
+			<-entry.ready
+			if entry.key == nil {
+				return
+			}
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			deleteObjTempKey(ctx, b.client, entry.key.ID)
+		}(entry)
+	}
+
+	wg.Wait()
+}
+
+// isExpired must be called with b.mu held.
+func (b *ObjTempKeyBroker) isExpired(entry *objTempKey) bool {
+	select {
+	case <-entry.ready:
+		return entry.err == nil && time.Since(entry.createdAt) >= b.ttl
+	default:
+		return false
+	}
+}
+
+// release drops a reference to the entry. The key handed out for its scope is
+// kept for later users, while keys which expired or failed to be created are
+// dropped once they aren't in use anymore.
+func (b *ObjTempKeyBroker) release(ctx context.Context, entry *objTempKey) {
+	b.mu.Lock()
+
+	entry.refs--
+	if entry.refs > 0 || b.keys[entry.scope] == entry {
+		b.mu.Unlock()
+		return
+	}
+
+	// The key has already been deleted if the broker was shut down
+	_, live := b.live[entry]
+	delete(b.live, entry)
+
+	b.mu.Unlock()
+
+	if !live || entry.key == nil {
+		return
+	}
+
+	// The key is deleted even if the operation using it has been cancelled
+	deleteObjTempKey(context.WithoutCancel(ctx), b.client, entry.key.ID)
+}
+
+// createObjTempKey creates a temporary Object Storage Key.
+// The key is scoped only to the target cluster and bucket with limited permissions.
+func createObjTempKey(
+	ctx context.Context,
+	client linodego.Client,
+	scope objTempKeyScope,
+) (*linodego.ObjectStorageKey, error) {
+	tflog.Debug(ctx, "Create temporary object storage access keys implicitly.")
+
+	createOpts := linodego.ObjectStorageKeyCreateOptions{
+		Label: fmt.Sprintf("temp_%s_%v", scope.Bucket, time.Now().Unix()),
+		BucketAccess: &[]linodego.ObjectStorageKeyBucketAccess{{
+			BucketName:  scope.Bucket,
+			Cluster:     scope.Cluster,
+			Permissions: scope.Permissions,
+		}},
+	}
+
+	tflog.Debug(ctx, "client.CreateObjectStorageKey(...)", map[string]any{
+		"options": createOpts,
+	})
+
+	return client.CreateObjectStorageKey(ctx, createOpts)
+}
+
+// deleteObjTempKey deletes a temporary Object Storage Key.
+func deleteObjTempKey(ctx context.Context, client linodego.Client, keyID int) {
+	tflog.Trace(ctx, "Clean up temporary keys: client.DeleteObjectStorageKey(...)", map[string]any{
+		"key_id": keyID,
+	})
+
+	if err := client.DeleteObjectStorageKey(ctx, keyID); err != nil {
+		tflog.Warn(ctx, "Failed to clean up temporary object storage keys", map[string]any{
+			"details": err,
+		})
+	}
+}
@@ -0,0 +1,245 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeObjKeyAPI records the object storage keys created and deleted through it.
+type fakeObjKeyAPI struct {
+	mu      sync.Mutex
+	nextID  int
+	created []linodego.ObjectStorageKeyCreateOptions
+	deleted []int
+}
+
+func (f *fakeObjKeyAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	switch r.Method {
+	case http.MethodPost:
+		var opts linodego.ObjectStorageKeyCreateOptions
+		if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		f.nextID++
+		f.created = append(f.created, opts)
+
+		_ = json.NewEncoder(w).Encode(linodego.ObjectStorageKey{
+			ID:        f.nextID,
+			Label:     opts.Label,
+			AccessKey: fmt.Sprintf("access-%d", f.nextID),
+			SecretKey: fmt.Sprintf("secret-%d", f.nextID),
+		})
+	case http.MethodDelete:
+		id, _ := strconv.Atoi(path.Base(r.URL.Path))
+		f.deleted = append(f.deleted, id)
+
+		_, _ = w.Write([]byte("{}"))
+	}
+}
+
+func (f *fakeObjKeyAPI) counts() (int, []int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.created), append([]int{}, f.deleted...)
+}
+
+func newFakeObjKeyClient(t *testing.T) (linodego.Client, *fakeObjKeyAPI) {
+	api := &fakeObjKeyAPI{}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	return client, api
+}
+
+func TestObjTempKeyBroker_sharesKeys(t *testing.T) {
+	ctx := context.Background()
+	client, api := newFakeObjKeyClient(t)
+	broker := helper.NewObjTempKeyBroker(client, time.Hour)
+
+	var wg sync.WaitGroup
+	accessKeys := make([]string, 10)
+	releases := make([]func(), 10)
+
+	for i := range accessKeys {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			key, release, err := broker.Acquire(ctx, "mybucket", "us-east-1", "read_write")
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			accessKeys[i] = key.AccessKey
+			releases[i] = release
+		}(i)
+	}
+	wg.Wait()
+
+	for _, accessKey := range accessKeys {
+		assert.Equal(t, "access-1", accessKey)
+	}
+
+	// A different permission results in a different key
+	key, release, err := broker.Acquire(ctx, "mybucket", "us-east-1", "read_only")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "access-2", key.AccessKey)
+
+	created, deleted := api.counts()
+	assert.Equal(t, 2, created)
+	assert.Empty(t, deleted)
+
+	// Released keys are kept for later users
+	release()
+	for _, release := range releases {
+		release()
+	}
+
+	key, release, err = broker.Acquire(ctx, "mybucket", "us-east-1", "read_write")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "access-1", key.AccessKey)
+	release()
+
+	created, deleted = api.counts()
+	assert.Equal(t, 2, created)
+	assert.Empty(t, deleted)
+
+	// and deleted on shutdown
+	broker.Shutdown(ctx)
+
+	created, deleted = api.counts()
+	assert.Equal(t, 2, created)
+	assert.ElementsMatch(t, []int{1, 2}, deleted)
+}
+
+func TestObjTempKeyBroker_expiry(t *testing.T) {
+	ctx := context.Background()
+	client, api := newFakeObjKeyClient(t)
+	broker := helper.NewObjTempKeyBroker(client, 0)
+
+	key, release, err := broker.Acquire(ctx, "mybucket", "us-east-1", "read_write")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 1, key.ID)
+
+	// The expired key is replaced, but not deleted while it's in use
+	newKey, releaseNew, err := broker.Acquire(ctx, "mybucket", "us-east-1", "read_write")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 2, newKey.ID)
+
+	_, deleted := api.counts()
+	assert.Empty(t, deleted)
+
+	release()
+
+	_, deleted = api.counts()
+	assert.Equal(t, []int{1}, deleted)
+
+	// An expired key which isn't in use is deleted once it's replaced
+	releaseNew()
+
+	_, deleted = api.counts()
+	assert.Equal(t, []int{1}, deleted)
+
+	lastKey, releaseLast, err := broker.Acquire(ctx, "mybucket", "us-east-1", "read_write")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 3, lastKey.ID)
+	releaseLast()
+
+	_, deleted = api.counts()
+	assert.Equal(t, []int{1, 2}, deleted)
+
+	broker.Shutdown(ctx)
+
+	created, deleted := api.counts()
+	assert.Equal(t, 3, created)
+	assert.Equal(t, []int{1, 2, 3}, deleted)
+}
+
+func TestObjTempKeyBroker_shutdown(t *testing.T) {
+	ctx := context.Background()
+	client, api := newFakeObjKeyClient(t)
+	broker := helper.NewObjTempKeyBroker(client, time.Hour)
+
+	releases := make([]func(), 0)
+
+	for _, bucket := range []string{"bucket-a", "bucket-b", "bucket-c"} {
+		_, release, err := broker.Acquire(ctx, bucket, "us-east-1", "read_write")
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		releases = append(releases, release)
+	}
+
+	// Keys still in use are deleted on shutdown
+	broker.Shutdown(ctx)
+
+	_, deleted := api.counts()
+	assert.ElementsMatch(t, []int{1, 2, 3}, deleted)
+
+	// and aren't deleted again once released
+	for _, release := range releases {
+		release()
+	}
+
+	_, deleted = api.counts()
+	assert.Len(t, deleted, 3)
+}
+
+func TestSharedObjTempKeyBroker(t *testing.T) {
+	ctx := context.Background()
+	client, api := newFakeObjKeyClient(t)
+
+	broker := helper.SharedObjTempKeyBroker(client, "token", "", time.Hour)
+	assert.Same(t, broker, helper.SharedObjTempKeyBroker(client, "token", helper.DefaultLinodeURL, time.Hour))
+	assert.NotSame(t, broker, helper.SharedObjTempKeyBroker(client, "other-token", "", time.Hour))
+
+	if _, _, err := broker.Acquire(ctx, "mybucket", "us-east-1", "read_write"); !assert.NoError(t, err) {
+		return
+	}
+
+	helper.ShutdownObjTempKeyBrokers(ctx)
+
+	_, deleted := api.counts()
+	assert.Equal(t, []int{1}, deleted)
+
+	// A new broker is created after the shutdown
+	assert.NotSame(t, broker, helper.SharedObjTempKeyBroker(client, "token", "", time.Hour))
+
+	helper.ShutdownObjTempKeyBrokers(ctx)
+}
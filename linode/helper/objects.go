@@ -17,7 +17,13 @@ import (
 	"github.com/linode/linodego"
 )
 
-func S3Connection(ctx context.Context, endpoint, accessKey, secretKey string) (*s3.Client, error) {
+// S3Connection creates an S3 client for the given endpoint. The endpoint
+// is accessed over HTTPS unless it includes a scheme.
+func S3Connection(
+	ctx context.Context,
+	endpoint, accessKey, secretKey string,
+	optFns ...func(*s3.Options),
+) (*s3.Client, error) {
 	tflog.Debug(ctx, "creating object storage client")
 	awsSDKConfig, err := config.LoadDefaultConfig(
 		context.Background(),
@@ -27,7 +33,7 @@ func S3Connection(ctx context.Context, endpoint, accessKey, secretKey string) (*
 		config.WithEndpointResolverWithOptions(
 			aws.EndpointResolverWithOptionsFunc(
 				func(service, region string, options ...interface{}) (aws.Endpoint, error) {
-					return aws.Endpoint{URL: "https://" + endpoint}, nil
+					return aws.Endpoint{URL: s3EndpointURL(endpoint)}, nil
 				},
 			),
 		),
@@ -38,11 +44,28 @@ func S3Connection(ctx context.Context, endpoint, accessKey, secretKey string) (*
 		return nil, err
 	}
 
-	return s3.NewFromConfig(awsSDKConfig), nil
+	return s3.NewFromConfig(awsSDKConfig, optFns...), nil
+}
+
+// WithS3PathStyle configures whether the S3 client addresses buckets
+// in the request path instead of the endpoint hostname.
+func WithS3PathStyle(usePathStyle bool) func(*s3.Options) {
+	return func(o *s3.Options) {
+		o.UsePathStyle = usePathStyle
+	}
+}
+
+func s3EndpointURL(endpoint string) string {
+	if strings.Contains(endpoint, "://") {
+		return endpoint
+	}
+
+	return "https://" + endpoint
 }
 
 // S3ConnectionFromData requires endpoint in the data.
 // If endpoint is empty a bucket and cluster are required.
+// The provider-level obj_endpoint_override takes precedence over both.
 func S3ConnectionFromData(
 	ctx context.Context,
 	d *schema.ResourceData,
@@ -50,7 +73,13 @@ func S3ConnectionFromData(
 	accessKey, secretKey string,
 ) (*s3.Client, error) {
 	tflog.Debug(ctx, "creating object storage client from resource data")
-	endpoint := d.Get("endpoint").(string)
+	config := meta.(*ProviderMeta).Config
+
+	endpoint := config.ObjEndpointOverride
+	if endpoint == "" {
+		endpoint = d.Get("endpoint").(string)
+	}
+
 	if endpoint == "" {
 		var err error
 		if endpoint, err = ComputeS3Endpoint(ctx, d, meta); err != nil {
@@ -58,10 +87,15 @@ func S3ConnectionFromData(
 		}
 	}
 
-	return S3Connection(ctx, endpoint, accessKey, secretKey)
+	return S3Connection(ctx, endpoint, accessKey, secretKey, WithS3PathStyle(config.ObjUsePathStyle))
 }
 
 func ComputeS3Endpoint(ctx context.Context, d *schema.ResourceData, meta interface{}) (string, error) {
+	if override := meta.(*ProviderMeta).Config.ObjEndpointOverride; override != "" {
+		tflog.Debug(ctx, "using the object storage endpoint override")
+		return override, nil
+	}
+
 	tflog.Debug(ctx, "getting object storage bucket from resource data")
 	cluster := d.Get("cluster").(string)
 	bucket := d.Get("bucket").(string)
@@ -74,6 +108,23 @@ func ComputeS3Endpoint(ctx context.Context, d *schema.ResourceData, meta interfa
 	return ComputeS3EndpointFromBucket(ctx, *b), nil
 }
 
+// FrameworkComputeS3Endpoint computes the S3 endpoint of the given bucket
+// for use in framework resources and data sources.
+func FrameworkComputeS3Endpoint(
+	ctx context.Context,
+	client *linodego.Client,
+	cluster, bucket string,
+) (string, error) {
+	tflog.Debug(ctx, "getting object storage bucket to compute its endpoint")
+
+	b, err := client.GetObjectStorageBucket(ctx, cluster, bucket)
+	if err != nil {
+		return "", fmt.Errorf("failed to find the specified Linode ObjectStorageBucket: %s", err)
+	}
+
+	return ComputeS3EndpointFromBucket(ctx, *b), nil
+}
+
 func ComputeS3EndpointFromBucket(ctx context.Context, bucket linodego.ObjectStorageBucket) string {
 	tflog.Debug(ctx, "computing object storage endpoint from bucket instance")
 	return strings.TrimPrefix(bucket.Hostname, fmt.Sprintf("%s.", bucket.Label))
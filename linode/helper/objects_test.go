@@ -0,0 +1,46 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func presignTestGetObject(t *testing.T, endpoint string, usePathStyle bool) string {
+	ctx := context.Background()
+
+	s3client, err := helper.S3Connection(
+		ctx, endpoint, "access", "secret", helper.WithS3PathStyle(usePathStyle),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := s3.NewPresignClient(s3client).PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String("mybucket"),
+		Key:    aws.String("mykey"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return req.URL
+}
+
+func TestS3Connection_endpoint(t *testing.T) {
+	assert.Regexp(t,
+		`^https://mybucket\.us-east-1\.linodeobjects\.com/mykey\?`,
+		presignTestGetObject(t, "us-east-1.linodeobjects.com", false),
+	)
+
+	assert.Regexp(t,
+		`^http://localhost:9000/mybucket/mykey\?`,
+		presignTestGetObject(t, "http://localhost:9000", true),
+	)
+}
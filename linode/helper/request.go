@@ -0,0 +1,42 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/linode/linodego"
+)
+
+// DoAPIRequest sends a request to the Linode API using the given client
+// and unmarshals the response body into a new value of type T.
+//
+// This should only be used for endpoints that are not yet supported by linodego.
+func DoAPIRequest[T any](
+	ctx context.Context,
+	client *linodego.Client,
+	method, endpoint string,
+	body any,
+) (*T, error) {
+	req := client.R(ctx).SetResult(new(T))
+
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+
+		req.SetBody(string(bodyBytes))
+	}
+
+	resp, err := req.Execute(method, endpoint)
+	if err != nil {
+		return nil, linodego.NewError(err)
+	}
+
+	if resp.IsError() {
+		return nil, linodego.NewError(resp)
+	}
+
+	return resp.Result().(*T), nil
+}
@@ -2,6 +2,7 @@ package helper
 
 import (
 	"net"
+	"net/netip"
 
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/go-cty/cty"
@@ -44,3 +45,29 @@ func SDKv2ObjectCannedACLValidator(i any, p cty.Path) diag.Diagnostics {
 	}
 	return validation.ToDiagFunc(validation.StringInSlice(aclValues, true))(i, p)
 }
+
+func SDKv2ValidateIPv4AddressOrRange(i any, path cty.Path) diag.Diagnostics {
+	normalized, err := NormalizeCIDR(i.(string))
+	if err != nil {
+		return diag.Errorf("Invalid IPv4 address or CIDR range: %s", i)
+	}
+
+	if !netip.MustParsePrefix(normalized).Addr().Is4() {
+		return diag.Errorf("Expected IPv4 address, got IPv6")
+	}
+
+	return nil
+}
+
+func SDKv2ValidateIPv6AddressOrRange(i any, path cty.Path) diag.Diagnostics {
+	normalized, err := NormalizeCIDR(i.(string))
+	if err != nil {
+		return diag.Errorf("Invalid IPv6 address or CIDR range: %s", i)
+	}
+
+	if netip.MustParsePrefix(normalized).Addr().Is4() {
+		return diag.Errorf("Expected IPv6 address, got IPv4")
+	}
+
+	return nil
+}
@@ -58,3 +58,45 @@ func TestSDKv2ValidateIPv6Range(t *testing.T) {
 		t.Fatal("Expected none, got error")
 	}
 }
+
+func TestSDKv2ValidateIPv4AddressOrRange(t *testing.T) {
+	d := helper.SDKv2ValidateIPv4AddressOrRange("192.168.0.1", nil)
+	if d != nil && d.HasError() {
+		t.Fatal("Expected none, got error")
+	}
+
+	d = helper.SDKv2ValidateIPv4AddressOrRange("192.168.0.0/24", nil)
+	if d != nil && d.HasError() {
+		t.Fatal("Expected none, got error")
+	}
+
+	d = helper.SDKv2ValidateIPv4AddressOrRange("::1", nil)
+	if d == nil || !d.HasError() {
+		t.Fatal("Expected error, got none")
+	}
+
+	if !strings.Contains(d[0].Summary, "Expected IPv4 address, got IPv6") {
+		t.Fatal("Error does not match expected error")
+	}
+}
+
+func TestSDKv2ValidateIPv6AddressOrRange(t *testing.T) {
+	d := helper.SDKv2ValidateIPv6AddressOrRange("2001:db8::1", nil)
+	if d != nil && d.HasError() {
+		t.Fatal("Expected none, got error")
+	}
+
+	d = helper.SDKv2ValidateIPv6AddressOrRange("192.168.0.1", nil)
+	if d == nil || !d.HasError() {
+		t.Fatal("Expected error, got none")
+	}
+
+	if !strings.Contains(d[0].Summary, "Expected IPv6 address, got IPv4") {
+		t.Fatal("Error does not match expected error")
+	}
+
+	d = helper.SDKv2ValidateIPv6AddressOrRange("invalid", nil)
+	if d == nil || !d.HasError() {
+		t.Fatal("Expected error, got none")
+	}
+}
@@ -1437,3 +1437,54 @@ func SafeShutdownInstance(ctx context.Context, client *linodego.Client, instance
 	tflog.Debug(ctx, "Linode instance shutdown finished")
 	return nil
 }
+
+// checkFirewallAssignment warns if the firewall the instance was created with
+// has been replaced by or combined with other firewalls, e.g. through
+// linode_firewall or linode_firewall_device resources.
+func checkFirewallAssignment(
+	ctx context.Context,
+	client linodego.Client,
+	instanceID int,
+	firewallID int,
+) diag.Diagnostics {
+	tflog.Trace(ctx, "client.ListInstanceFirewalls(...)")
+
+	firewalls, err := client.ListInstanceFirewalls(ctx, instanceID, nil)
+	if err != nil {
+		// The check is informational only, so it never fails the read
+		tflog.Warn(ctx, "Failed to check the firewall assignment of the Linode instance", map[string]any{
+			"details": err,
+		})
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	attached := false
+
+	for _, firewall := range firewalls {
+		if firewall.ID == firewallID {
+			attached = true
+			continue
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary: fmt.Sprintf("Linode instance %d is attached to firewall %d (%s)",
+				instanceID, firewall.ID, firewall.Label),
+			Detail: fmt.Sprintf("The instance was created with firewall_id %d, but has been attached "+
+				"to another firewall outside of linode_instance.", firewallID),
+		})
+	}
+
+	if !attached {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Linode instance %d is no longer attached to firewall %d", instanceID, firewallID),
+			Detail: "The firewall_id of linode_instance is only applied during creation. " +
+				"Attach the instance using linode_firewall or linode_firewall_device to manage it afterwards, " +
+				"or set skip_instance_firewall_check in the provider configuration to skip this check.",
+		})
+	}
+
+	return diags
+}
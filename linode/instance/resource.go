@@ -148,6 +148,11 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta interface{})
 		d.Set("boot_config_label", defaultConfig.Label)
 	}
 
+	firewallID, ok := d.GetOk("firewall_id")
+	if ok && !meta.(*helper.ProviderMeta).Config.SkipInstanceFirewallCheck {
+		return checkFirewallAssignment(ctx, client, id, firewallID.(int))
+	}
+
 	return nil
 }
 
@@ -3,15 +3,57 @@ package lke
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"reflect"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"github.com/linode/terraform-provider-linode/v2/linode/lkenodepool"
 )
 
+const kubeconfigRegenerateTimeout = 5 * time.Minute
+
+const (
+	recycleStrategyCluster = "cluster"
+	recycleStrategyPool    = "pool"
+)
+
+// lkeControlPlaneACL represents the ACL configuration of an LKE cluster's control plane.
+// NOTE: This is not yet supported by linodego.
+type lkeControlPlaneACL struct {
+	Enabled   bool                         `json:"enabled"`
+	Addresses *lkeControlPlaneACLAddresses `json:"addresses,omitempty"`
+}
+
+type lkeControlPlaneACLAddresses struct {
+	IPv4 []string `json:"ipv4"`
+	IPv6 []string `json:"ipv6"`
+}
+
+type lkeControlPlaneACLBody struct {
+	ACL lkeControlPlaneACL `json:"acl"`
+}
+
+// lkeClusterCreateOptions extends the linodego create options with the
+// control plane ACL so it is in effect as soon as the cluster is created.
+// NOTE: This is not yet supported by linodego.
+type lkeClusterCreateOptions struct {
+	linodego.LKEClusterCreateOptions
+	ControlPlane *lkeClusterControlPlaneCreateOptions `json:"control_plane,omitempty"`
+}
+
+type lkeClusterControlPlaneCreateOptions struct {
+	HighAvailability bool                `json:"high_availability"`
+	ACL              *lkeControlPlaneACL `json:"acl,omitempty"`
+}
+
 type NodePoolSpec struct {
 	ID                int
 	Type              string
@@ -259,6 +301,121 @@ func recycleLKECluster(ctx context.Context, meta *helper.ProviderMeta, id int, p
 	return nil
 }
 
+// recycleLKEClusterPools recycles each of the given node pools one at a time,
+// waiting for each pool's nodes to be replaced before moving on to the next pool.
+func recycleLKEClusterPools(ctx context.Context, meta *helper.ProviderMeta, id int, pools []linodego.LKENodePool) error {
+	client := meta.Client
+
+	ctx = tflog.SetField(ctx, "cluster_id", id)
+
+	tflog.Info(ctx, "Recycling LKE cluster node pools one at a time")
+
+	for _, pool := range pools {
+		poolCtx := tflog.SetField(ctx, "node_pool_id", pool.ID)
+
+		tflog.Trace(poolCtx, "recycleLKENodePool(...)")
+
+		if err := recycleLKENodePool(poolCtx, &client, id, pool.ID); err != nil {
+			return fmt.Errorf("failed to recycle LKE Cluster (%d) Pool (%d): %w", id, pool.ID, err)
+		}
+
+		tflog.Debug(poolCtx, "Waiting for all nodes in pool to be deleted", map[string]any{
+			"nodes": pool.Linodes,
+		})
+
+		if err := waitForNodesDeleted(poolCtx, client, meta.Config.EventPollMilliseconds, pool.Linodes); err != nil {
+			return fmt.Errorf("failed to wait for old nodes in pool %d to be recycled: %w", pool.ID, err)
+		}
+
+		tflog.Debug(poolCtx, "All old nodes in pool detected as deleted, waiting for pool to enter ready status")
+
+		if _, err := lkenodepool.WaitForNodePoolReady(
+			poolCtx, client, meta.Config.EventPollMilliseconds, id, pool.ID,
+		); err != nil {
+			return fmt.Errorf("failed to wait for pool %d ready: %w", pool.ID, err)
+		}
+	}
+
+	tflog.Debug(ctx, "All node pools have been recycled; recycle operation completed")
+
+	return nil
+}
+
+// recycleLKENodePool recycles all nodes in the given node pool.
+// NOTE: This endpoint is not yet supported by linodego.
+func recycleLKENodePool(ctx context.Context, client *linodego.Client, clusterID, poolID int) error {
+	_, err := helper.DoAPIRequest[struct{}](
+		ctx,
+		client,
+		http.MethodPost,
+		fmt.Sprintf("lke/clusters/%d/pools/%d/recycle", clusterID, poolID),
+		nil,
+	)
+	return err
+}
+
+// validateLKEVersionUpgrade ensures the given version upgrade is available
+// and only advances a single minor version.
+func validateLKEVersionUpgrade(oldVersion, newVersion string, availableVersions []string) error {
+	if !slices.Contains(availableVersions, newVersion) {
+		return fmt.Errorf(
+			"k8s_version %s is not available; available versions: %s",
+			newVersion, strings.Join(availableVersions, ", "),
+		)
+	}
+
+	oldParsed, err := version.NewVersion(oldVersion)
+	if err != nil {
+		return fmt.Errorf("failed to parse k8s_version %s: %w", oldVersion, err)
+	}
+
+	newParsed, err := version.NewVersion(newVersion)
+	if err != nil {
+		return fmt.Errorf("failed to parse k8s_version %s: %w", newVersion, err)
+	}
+
+	oldSegments, newSegments := oldParsed.Segments(), newParsed.Segments()
+
+	if newSegments[0] != oldSegments[0] || newSegments[1] != oldSegments[1]+1 {
+		return fmt.Errorf(
+			"k8s_version can only be upgraded one minor version at a time; got %s -> %s",
+			oldVersion, newVersion,
+		)
+	}
+
+	return nil
+}
+
+func regenerateLKEClusterKubeconfig(ctx context.Context, client linodego.Client, id int) error {
+	tflog.Info(ctx, "Regenerating LKE cluster kubeconfig")
+	tflog.Trace(ctx, "client.RegenerateLKECluster(...)")
+
+	if _, err := client.RegenerateLKECluster(ctx, id, linodego.LKEClusterRegenerateOptions{
+		KubeConfig: true,
+	}); err != nil {
+		return fmt.Errorf("failed to regenerate kubeconfig for LKE Cluster (%d): %w", id, err)
+	}
+
+	// The kubeconfig is temporarily unavailable while it is being regenerated,
+	// so we should wait for it to be retrievable before refreshing the state.
+	err := retry.RetryContext(ctx, kubeconfigRegenerateTimeout, func() *retry.RetryError {
+		tflog.Trace(ctx, "client.GetLKEClusterKubeconfig(...)")
+
+		if _, err := client.GetLKEClusterKubeconfig(ctx, id); err != nil {
+			return retry.RetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to wait for regenerated kubeconfig for LKE Cluster (%d): %w", id, err)
+	}
+
+	tflog.Debug(ctx, "Regenerated kubeconfig is available; regenerate operation completed")
+
+	return nil
+}
+
 // This cannot currently be handled efficiently by a DiffSuppressFunc
 // See: https://github.com/hashicorp/terraform-plugin-sdk/issues/477
 func matchPoolsWithSchema(pools []linodego.LKENodePool, declaredPools []interface{}) ([]linodego.LKENodePool, error) {
@@ -430,14 +587,63 @@ func flattenLKENodePools(pools []linodego.LKEClusterPool) []map[string]interface
 	return flattened
 }
 
-func flattenLKEClusterControlPlane(controlPlane linodego.LKEClusterControlPlane) map[string]interface{} {
+func flattenLKEClusterControlPlane(
+	controlPlane linodego.LKEClusterControlPlane,
+	acl *lkeControlPlaneACL,
+	declaredControlPlane []any,
+) map[string]interface{} {
 	flattened := make(map[string]interface{})
 
 	flattened["high_availability"] = controlPlane.HighAvailability
 
+	if acl != nil {
+		var declaredACL map[string]any
+		if len(declaredControlPlane) > 0 && declaredControlPlane[0] != nil {
+			if declared, ok := declaredControlPlane[0].(map[string]any)["acl"].([]any); ok &&
+				len(declared) > 0 && declared[0] != nil {
+				declaredACL = declared[0].(map[string]any)
+			}
+		}
+
+		flattened["acl"] = []map[string]interface{}{flattenLKEClusterControlPlaneACL(*acl, declaredACL)}
+	}
+
 	return flattened
 }
 
+// flattenLKEClusterControlPlaneACL flattens the given control plane ACL,
+// preserving the declared representation of any addresses that are
+// equivalent to the addresses returned by the API.
+func flattenLKEClusterControlPlaneACL(acl lkeControlPlaneACL, declaredACL map[string]any) map[string]interface{} {
+	var ipv4, ipv6 []string
+	if acl.Addresses != nil {
+		ipv4, ipv6 = acl.Addresses.IPv4, acl.Addresses.IPv6
+	}
+
+	if declaredAddresses, ok := declaredACL["addresses"].([]any); ok &&
+		len(declaredAddresses) > 0 && declaredAddresses[0] != nil {
+		declared := declaredAddresses[0].(map[string]any)
+
+		if declaredIPv4 := helper.ExpandStringSet(declared["ipv4"].(*schema.Set)); helper.CIDRListsEquivalent(declaredIPv4, ipv4) {
+			ipv4 = declaredIPv4
+		}
+
+		if declaredIPv6 := helper.ExpandStringSet(declared["ipv6"].(*schema.Set)); helper.CIDRListsEquivalent(declaredIPv6, ipv6) {
+			ipv6 = declaredIPv6
+		}
+	}
+
+	return map[string]interface{}{
+		"enabled": acl.Enabled,
+		"addresses": []map[string]interface{}{
+			{
+				"ipv4": ipv4,
+				"ipv6": ipv6,
+			},
+		},
+	}
+}
+
 func expandLKEClusterControlPlane(controlPlane map[string]interface{}) linodego.LKEClusterControlPlane {
 	var result linodego.LKEClusterControlPlane
 
@@ -448,6 +654,81 @@ func expandLKEClusterControlPlane(controlPlane map[string]interface{}) linodego.
 	return result
 }
 
+func expandLKEClusterControlPlaneACL(controlPlane map[string]interface{}) *lkeControlPlaneACL {
+	aclList, ok := controlPlane["acl"].([]interface{})
+	if !ok || len(aclList) < 1 || aclList[0] == nil {
+		return nil
+	}
+
+	aclSpec := aclList[0].(map[string]interface{})
+
+	result := lkeControlPlaneACL{
+		Enabled: aclSpec["enabled"].(bool),
+	}
+
+	if addresses, ok := aclSpec["addresses"].([]interface{}); ok && len(addresses) > 0 && addresses[0] != nil {
+		addressesSpec := addresses[0].(map[string]interface{})
+
+		result.Addresses = &lkeControlPlaneACLAddresses{
+			IPv4: helper.ExpandStringSet(addressesSpec["ipv4"].(*schema.Set)),
+			IPv6: helper.ExpandStringSet(addressesSpec["ipv6"].(*schema.Set)),
+		}
+	}
+
+	return &result
+}
+
+// createLKECluster always posts the extended options, since the control plane
+// of the embedded linodego options is shadowed by the ACL-aware one.
+func createLKECluster(
+	ctx context.Context,
+	client *linodego.Client,
+	opts lkeClusterCreateOptions,
+) (*linodego.LKECluster, error) {
+	return helper.DoAPIRequest[linodego.LKECluster](
+		ctx,
+		client,
+		http.MethodPost,
+		"lke/clusters",
+		opts,
+	)
+}
+
+func getLKEControlPlaneACL(ctx context.Context, client *linodego.Client, clusterID int) (*lkeControlPlaneACL, error) {
+	result, err := helper.DoAPIRequest[lkeControlPlaneACLBody](
+		ctx,
+		client,
+		http.MethodGet,
+		fmt.Sprintf("lke/clusters/%d/control_plane_acl", clusterID),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.ACL, nil
+}
+
+func updateLKEControlPlaneACL(
+	ctx context.Context,
+	client *linodego.Client,
+	clusterID int,
+	acl lkeControlPlaneACL,
+) (*lkeControlPlaneACL, error) {
+	result, err := helper.DoAPIRequest[lkeControlPlaneACLBody](
+		ctx,
+		client,
+		http.MethodPut,
+		fmt.Sprintf("lke/clusters/%d/control_plane_acl", clusterID),
+		lkeControlPlaneACLBody{ACL: acl},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.ACL, nil
+}
+
 func filterExternalPools(ctx context.Context, externalPoolTags []string, pools []linodego.LKENodePool) []linodego.LKENodePool {
 	var filteredPools []linodego.LKENodePool
 	if len(externalPoolTags) == 0 {
@@ -0,0 +1,187 @@
+//go:build unit
+
+package lke
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/linodego"
+)
+
+func TestValidateLKEVersionUpgrade(t *testing.T) {
+	availableVersions := []string{"1.27", "1.28", "1.29"}
+
+	for _, tc := range []struct {
+		name        string
+		oldVersion  string
+		newVersion  string
+		expectError bool
+	}{
+		{
+			name:       "single minor version",
+			oldVersion: "1.28",
+			newVersion: "1.29",
+		},
+		{
+			name:        "multiple minor versions",
+			oldVersion:  "1.27",
+			newVersion:  "1.29",
+			expectError: true,
+		},
+		{
+			name:        "downgrade",
+			oldVersion:  "1.29",
+			newVersion:  "1.28",
+			expectError: true,
+		},
+		{
+			name:        "unavailable version",
+			oldVersion:  "1.29",
+			newVersion:  "1.30",
+			expectError: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateLKEVersionUpgrade(tc.oldVersion, tc.newVersion, availableVersions)
+			if tc.expectError && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+		})
+	}
+}
+
+func TestFlattenLKEClusterControlPlaneACL(t *testing.T) {
+	acl := lkeControlPlaneACL{
+		Enabled: true,
+		Addresses: &lkeControlPlaneACLAddresses{
+			IPv4: []string{"10.0.0.1/32", "192.168.0.0/24"},
+			IPv6: []string{"2001:db8::/32"},
+		},
+	}
+
+	declaredACL := map[string]any{
+		"enabled": true,
+		"addresses": []any{
+			map[string]any{
+				"ipv4": schema.NewSet(schema.HashString, []any{"192.168.0.0/24", "10.0.0.1"}),
+				"ipv6": schema.NewSet(schema.HashString, []any{"2001:db8::1"}),
+			},
+		},
+	}
+
+	result := flattenLKEClusterControlPlaneACL(acl, declaredACL)
+	addresses := result["addresses"].([]map[string]any)[0]
+
+	// Equivalent addresses should retain their declared representation
+	if !reflect.DeepEqual(addresses["ipv4"], []string{"10.0.0.1", "192.168.0.0/24"}) &&
+		!reflect.DeepEqual(addresses["ipv4"], []string{"192.168.0.0/24", "10.0.0.1"}) {
+		t.Errorf("unexpected ipv4 addresses: %v", addresses["ipv4"])
+	}
+
+	// Drifted addresses should be reflected from the API
+	if !reflect.DeepEqual(addresses["ipv6"], []string{"2001:db8::/32"}) {
+		t.Errorf("unexpected ipv6 addresses: %v", addresses["ipv6"])
+	}
+
+	if result["enabled"] != true {
+		t.Errorf("expected ACL to be enabled")
+	}
+}
+
+func TestLKEClusterCreateOptionsControlPlaneACL(t *testing.T) {
+	opts := lkeClusterCreateOptions{
+		LKEClusterCreateOptions: linodego.LKEClusterCreateOptions{
+			Label:      "cluster",
+			Region:     "us-east",
+			K8sVersion: "1.29",
+		},
+		ControlPlane: &lkeClusterControlPlaneCreateOptions{
+			HighAvailability: true,
+			ACL: &lkeControlPlaneACL{
+				Enabled: true,
+				Addresses: &lkeControlPlaneACLAddresses{
+					IPv4: []string{"10.0.0.1"},
+					IPv6: []string{},
+				},
+			},
+		},
+	}
+
+	body, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal(body, &result); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]any{
+		"high_availability": true,
+		"acl": map[string]any{
+			"enabled": true,
+			"addresses": map[string]any{
+				"ipv4": []any{"10.0.0.1"},
+				"ipv6": []any{},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(result["control_plane"], expected) {
+		t.Fatalf("unexpected control_plane: %v", result["control_plane"])
+	}
+}
+
+func TestCreateLKEClusterHighAvailabilityWithoutACL(t *testing.T) {
+	var body map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 123, "label": "cluster"}`))
+	}))
+	defer server.Close()
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	opts := lkeClusterCreateOptions{
+		LKEClusterCreateOptions: linodego.LKEClusterCreateOptions{
+			Label:      "cluster",
+			Region:     "us-east",
+			K8sVersion: "1.29",
+		},
+		ControlPlane: &lkeClusterControlPlaneCreateOptions{
+			HighAvailability: true,
+		},
+	}
+
+	cluster, err := createLKECluster(context.Background(), &client, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cluster.ID != 123 {
+		t.Fatalf("expected cluster 123, got %d", cluster.ID)
+	}
+
+	expected := map[string]any{"high_availability": true}
+	if !reflect.DeepEqual(body["control_plane"], expected) {
+		t.Fatalf("expected control_plane %v, got %v", expected, body["control_plane"])
+	}
+}
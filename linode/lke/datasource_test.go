@@ -39,6 +39,9 @@ func TestAccDataSourceLKECluster_basic(t *testing.T) {
 						resource.TestCheckResourceAttr(dataSourceClusterName, "control_plane.0.high_availability", "false"),
 						resource.TestCheckResourceAttrSet(dataSourceClusterName, "pools.0.id"),
 						resource.TestCheckResourceAttrSet(dataSourceClusterName, "kubeconfig"),
+						resource.TestCheckResourceAttrSet(dataSourceClusterName, "kube_host"),
+						resource.TestCheckResourceAttrSet(dataSourceClusterName, "cluster_ca_certificate"),
+						resource.TestCheckResourceAttrSet(dataSourceClusterName, "client_token"),
 						resource.TestCheckResourceAttrSet(dataSourceClusterName, "dashboard_url"),
 					),
 				},
@@ -39,6 +39,19 @@ var frameworkDataSourceSchema = schema.Schema{
 			Sensitive:   true,
 			Description: "The Base64-encoded Kubeconfig for the cluster.",
 		},
+		"kube_host": schema.StringAttribute{
+			Computed:    true,
+			Description: "The Kubernetes API server host parsed from the cluster's Kubeconfig.",
+		},
+		"cluster_ca_certificate": schema.StringAttribute{
+			Computed:    true,
+			Description: "The PEM-encoded cluster CA certificate parsed from the cluster's Kubeconfig.",
+		},
+		"client_token": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The client bearer token parsed from the cluster's Kubeconfig.",
+		},
 		"dashboard_url": schema.StringAttribute{
 			Computed:    true,
 			Description: "The dashboard URL of the cluster.",
@@ -28,7 +28,10 @@ type LKEDataModel struct {
 	Pools []LKENodePool `tfsdk:"pools"`
 
 	// LKE Cluster Kubeconfig
-	Kubeconfig types.String `tfsdk:"kubeconfig"`
+	Kubeconfig           types.String `tfsdk:"kubeconfig"`
+	KubeHost             types.String `tfsdk:"kube_host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	ClientToken          types.String `tfsdk:"client_token"`
 
 	// LKE Cluster API endpoints
 	APIEndpoints types.List `tfsdk:"api_endpoints"`
@@ -148,6 +151,23 @@ func (data *LKEDataModel) parseLKEAttributes(
 
 	data.Kubeconfig = types.StringValue(kubeconfig.KubeConfig)
 
+	var warnings diag.Diagnostics
+
+	// The parsed attributes are left null rather than failing the read,
+	// since the raw kubeconfig is still usable.
+	kubeconfigDetails, err := ParseKubeconfig(kubeconfig.KubeConfig)
+	if err != nil {
+		warnings.AddWarning("Failed to parse kubeconfig", err.Error())
+
+		data.KubeHost = types.StringNull()
+		data.ClusterCACertificate = types.StringNull()
+		data.ClientToken = types.StringNull()
+	} else {
+		data.KubeHost = types.StringValue(kubeconfigDetails.Host)
+		data.ClusterCACertificate = types.StringValue(kubeconfigDetails.ClusterCACertificate)
+		data.ClientToken = types.StringValue(kubeconfigDetails.ClientToken)
+	}
+
 	var urls []string
 	for _, e := range endpoints {
 		urls = append(urls, e.Endpoint)
@@ -161,7 +181,7 @@ func (data *LKEDataModel) parseLKEAttributes(
 
 	data.DashboardURL = types.StringValue(dashboard.URL)
 
-	return nil
+	return warnings
 }
 
 func ParseControlPlane(
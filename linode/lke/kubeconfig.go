@@ -0,0 +1,51 @@
+package lke
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// KubeconfigDetails contains the connection details extracted from
+// an LKE cluster's kubeconfig.
+type KubeconfigDetails struct {
+	Host                 string
+	ClusterCACertificate string
+	ClientToken          string
+}
+
+// ParseKubeconfig decodes the given Base64-encoded kubeconfig and
+// resolves the connection details for its current context.
+func ParseKubeconfig(encodedKubeconfig string) (*KubeconfigDetails, error) {
+	rawKubeconfig, err := base64.StdEncoding.DecodeString(encodedKubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode kubeconfig: %w", err)
+	}
+
+	config, err := clientcmd.Load(rawKubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+
+	kubeContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("kubeconfig context %q not found", config.CurrentContext)
+	}
+
+	cluster, ok := config.Clusters[kubeContext.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("kubeconfig cluster %q not found", kubeContext.Cluster)
+	}
+
+	authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]
+	if !ok {
+		return nil, fmt.Errorf("kubeconfig user %q not found", kubeContext.AuthInfo)
+	}
+
+	return &KubeconfigDetails{
+		Host:                 cluster.Server,
+		ClusterCACertificate: string(cluster.CertificateAuthorityData),
+		ClientToken:          authInfo.Token,
+	}, nil
+}
@@ -0,0 +1,62 @@
+//go:build unit
+
+package lke_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/lke"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+preferences: {}
+current-context: lke12345-ctx
+clusters:
+- cluster:
+    certificate-authority-data: LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCmZvbwotLS0tLUVORCBDRVJUSUZJQ0FURS0tLS0tCg==
+    server: https://example.us-mia-1.linodelke.net:443
+  name: lke12345
+contexts:
+- context:
+    cluster: lke12345
+    namespace: default
+    user: lke12345-admin
+  name: lke12345-ctx
+users:
+- name: lke12345-admin
+  user:
+    token: abcdef123456
+`
+
+func TestParseKubeconfig(t *testing.T) {
+	details, err := lke.ParseKubeconfig(base64.StdEncoding.EncodeToString([]byte(testKubeconfig)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if details.Host != "https://example.us-mia-1.linodelke.net:443" {
+		t.Errorf("unexpected host: %s", details.Host)
+	}
+
+	expectedCA := "-----BEGIN CERTIFICATE-----\nfoo\n-----END CERTIFICATE-----\n"
+	if details.ClusterCACertificate != expectedCA {
+		t.Errorf("unexpected cluster CA certificate: %s", details.ClusterCACertificate)
+	}
+
+	if details.ClientToken != "abcdef123456" {
+		t.Errorf("unexpected client token: %s", details.ClientToken)
+	}
+}
+
+func TestParseKubeconfig_invalid(t *testing.T) {
+	if _, err := lke.ParseKubeconfig("not base64!"); err == nil {
+		t.Error("expected error for invalid base64")
+	}
+
+	noContext := base64.StdEncoding.EncodeToString([]byte("apiVersion: v1\nkind: Config\ncurrent-context: missing\n"))
+	if _, err := lke.ParseKubeconfig(noContext); err == nil {
+		t.Error("expected error for missing context")
+	}
+}
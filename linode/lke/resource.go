@@ -38,8 +38,11 @@ func Resource() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			customDiffValidateOptionalCount,
+			customDiffValidateUpgrade,
 			linodediffs.ComputedWithDefault("tags", []string{}),
 			linodediffs.CaseInsensitiveSet("tags"),
+			customdiff.ComputedIf("kubeconfig", kubeconfigRegenerationRequested),
+			customdiff.ComputedIf("client_token", kubeconfigRegenerationRequested),
 		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(createLKETimeout),
@@ -98,7 +101,60 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.Errorf("failed to get API endpoints for LKE cluster %d: %s", id, err)
 	}
 
-	flattenedControlPlane := flattenLKEClusterControlPlane(cluster.ControlPlane)
+	var diags diag.Diagnostics
+
+	// The parsed attributes are left empty rather than failing the read,
+	// since the raw kubeconfig is still usable.
+	kubeconfigDetails, err := ParseKubeconfig(kubeconfig.KubeConfig)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Failed to parse kubeconfig",
+			Detail: fmt.Sprintf(
+				"kube_host, cluster_ca_certificate and client_token are not set because the kubeconfig "+
+					"for LKE cluster %d could not be parsed: %s", id, err,
+			),
+		})
+		kubeconfigDetails = &KubeconfigDetails{}
+	}
+
+	tflog.Trace(ctx, "getLKEControlPlaneACL(...)")
+	acl, err := getLKEControlPlaneACL(ctx, &client, id)
+	if err != nil {
+		lerr, ok := err.(*linodego.Error)
+		if !ok {
+			return diag.Errorf("failed to get control plane ACL for LKE cluster %d: %s", id, err)
+		}
+
+		// The control plane ACL may not be available for all clusters or accounts
+		if lerr.Code != 404 && lerr.Code != 400 && lerr.Code != 403 {
+			return diag.Errorf("failed to get control plane ACL for LKE cluster %d: %s", id, err)
+		}
+
+		acl = nil
+
+		// The ACL known from state is kept rather than planning to set it again
+		if lerr.Code != 404 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Control plane ACL unavailable",
+				Detail: fmt.Sprintf(
+					"control_plane.0.acl is not refreshed because the control plane ACL of LKE cluster %d "+
+						"could not be retrieved: %s", id, err,
+				),
+			})
+
+			if current := d.Get("control_plane").([]interface{}); len(current) > 0 && current[0] != nil {
+				acl = expandLKEClusterControlPlaneACL(current[0].(map[string]interface{}))
+			}
+		}
+	}
+
+	flattenedControlPlane := flattenLKEClusterControlPlane(
+		cluster.ControlPlane,
+		acl,
+		d.Get("control_plane").([]interface{}),
+	)
 
 	tflog.Trace(ctx, "client.GetLKEClusterDashboard(...)")
 	dashboard, err := client.GetLKEClusterDashboard(ctx, id)
@@ -112,6 +168,9 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta interface{})
 	d.Set("tags", cluster.Tags)
 	d.Set("status", cluster.Status)
 	d.Set("kubeconfig", kubeconfig.KubeConfig)
+	d.Set("kube_host", kubeconfigDetails.Host)
+	d.Set("cluster_ca_certificate", kubeconfigDetails.ClusterCACertificate)
+	d.Set("client_token", kubeconfigDetails.ClientToken)
 	d.Set("dashboard_url", dashboard.URL)
 	d.Set("api_endpoints", flattenLKEClusterAPIEndpoints(endpoints))
 
@@ -125,7 +184,7 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta interface{})
 	d.Set("pool", p)
 	d.Set("control_plane", []map[string]interface{}{flattenedControlPlane})
 
-	return nil
+	return diags
 }
 
 func createResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -136,15 +195,21 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	controlPlane := d.Get("control_plane").([]interface{})
 
-	createOpts := linodego.LKEClusterCreateOptions{
-		Label:      d.Get("label").(string),
-		Region:     d.Get("region").(string),
-		K8sVersion: d.Get("k8s_version").(string),
+	createOpts := lkeClusterCreateOptions{
+		LKEClusterCreateOptions: linodego.LKEClusterCreateOptions{
+			Label:      d.Get("label").(string),
+			Region:     d.Get("region").(string),
+			K8sVersion: d.Get("k8s_version").(string),
+		},
 	}
 
-	if len(controlPlane) > 0 {
-		expandedControlPlane := expandLKEClusterControlPlane(controlPlane[0].(map[string]interface{}))
-		createOpts.ControlPlane = &expandedControlPlane
+	if len(controlPlane) > 0 && controlPlane[0] != nil {
+		controlPlaneSpec := controlPlane[0].(map[string]interface{})
+		expandedControlPlane := expandLKEClusterControlPlane(controlPlaneSpec)
+		createOpts.ControlPlane = &lkeClusterControlPlaneCreateOptions{
+			HighAvailability: expandedControlPlane.HighAvailability,
+			ACL:              expandLKEClusterControlPlaneACL(controlPlaneSpec),
+		}
 	}
 
 	for _, nodePool := range d.Get("pool").([]interface{}) {
@@ -179,16 +244,17 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		createOpts.Tags = tags
 	}
 
-	tflog.Debug(ctx, "client.CreateLKECluster(...)", map[string]any{
+	tflog.Debug(ctx, "createLKECluster(...)", map[string]any{
 		"options": createOpts,
 	})
-	cluster, err := client.CreateLKECluster(ctx, createOpts)
+	cluster, err := createLKECluster(ctx, &client, createOpts)
 	if err != nil {
 		return diag.Errorf("failed to create LKE cluster: %s", err)
 	}
 	d.SetId(strconv.Itoa(cluster.ID))
 
 	ctx = tflog.SetField(ctx, "cluster_id", cluster.ID)
+
 	tflog.Debug(ctx, "Waiting for a single LKE cluster node to be ready")
 
 	// Sometimes the K8S API will raise an EOF error if polling immediately after
@@ -254,6 +320,24 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		}
 	}
 
+	if d.HasChange("control_plane.0.acl") && len(controlPlane) > 0 && controlPlane[0] != nil {
+		if acl := expandLKEClusterControlPlaneACL(controlPlane[0].(map[string]interface{})); acl != nil {
+			tflog.Debug(ctx, "updateLKEControlPlaneACL(...)", map[string]any{
+				"acl": acl,
+			})
+
+			if _, err := updateLKEControlPlaneACL(ctx, &client, id, *acl); err != nil {
+				return diag.Errorf("failed to update control plane ACL for LKE Cluster %d: %s", id, err)
+			}
+		}
+	}
+
+	if d.HasChange("kubeconfig_regeneration_trigger") {
+		if err := regenerateLKEClusterKubeconfig(ctx, client, id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	tflog.Trace(ctx, "client.ListLKENodePools(...)")
 
 	pools, err := client.ListLKENodePools(ctx, id, nil)
@@ -262,10 +346,24 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 	}
 
 	if d.HasChange("k8s_version") {
-		tflog.Debug(ctx, "Implicitly recycling LKE cluster to apply Kubernetes version upgrade")
+		recycleStrategy := recycleStrategyCluster
+		if upgrade, ok := d.Get("upgrade").([]any); ok && len(upgrade) > 0 && upgrade[0] != nil {
+			recycleStrategy = upgrade[0].(map[string]any)["recycle_strategy"].(string)
+		}
 
-		if err := recycleLKECluster(ctx, providerMeta, id, pools); err != nil {
-			return diag.FromErr(err)
+		switch recycleStrategy {
+		case recycleStrategyPool:
+			tflog.Debug(ctx, "Recycling LKE cluster node pools one at a time to apply Kubernetes version upgrade")
+
+			if err := recycleLKEClusterPools(ctx, providerMeta, id, pools); err != nil {
+				return diag.FromErr(err)
+			}
+		default:
+			tflog.Debug(ctx, "Implicitly recycling LKE cluster to apply Kubernetes version upgrade")
+
+			if err := recycleLKECluster(ctx, providerMeta, id, pools); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
 
@@ -391,6 +489,42 @@ func flattenLKEClusterAPIEndpoints(apiEndpoints []linodego.LKEClusterAPIEndpoint
 	return flattened
 }
 
+// customDiffValidateUpgrade ensures Kubernetes version upgrades advance
+// a single minor version at a time when the upgrade workflow is enabled.
+func customDiffValidateUpgrade(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() == "" || !diff.HasChange("k8s_version") || !diff.NewValueKnown("k8s_version") {
+		return nil
+	}
+
+	if upgrade, ok := diff.Get("upgrade").([]any); !ok || len(upgrade) < 1 {
+		return nil
+	}
+
+	oldVersion, newVersion := diff.GetChange("k8s_version")
+
+	client := meta.(*helper.ProviderMeta).Client
+
+	tflog.Trace(ctx, "client.ListLKEVersions(...)")
+
+	versions, err := client.ListLKEVersions(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list LKE versions: %w", err)
+	}
+
+	availableVersions := make([]string, len(versions))
+	for i, v := range versions {
+		availableVersions[i] = v.ID
+	}
+
+	return validateLKEVersionUpgrade(oldVersion.(string), newVersion.(string), availableVersions)
+}
+
+// kubeconfigRegenerationRequested returns whether the kubeconfig of an
+// existing cluster will be regenerated during this apply.
+func kubeconfigRegenerationRequested(ctx context.Context, diff *schema.ResourceDiff, meta any) bool {
+	return diff.Id() != "" && diff.HasChange("kubeconfig_regeneration_trigger")
+}
+
 func populateLogAttributes(ctx context.Context, d *schema.ResourceData) context.Context {
 	return helper.SetLogFieldBulk(ctx, map[string]any{
 		"cluster_id": d.Id(),
@@ -191,6 +191,9 @@ func TestAccResourceLKECluster_basic_smoke(t *testing.T) {
 						resource.TestCheckResourceAttrSet(resourceClusterName, "id"),
 						resource.TestCheckResourceAttrSet(resourceClusterName, "pool.0.id"),
 						resource.TestCheckResourceAttrSet(resourceClusterName, "kubeconfig"),
+						resource.TestCheckResourceAttrSet(resourceClusterName, "kube_host"),
+						resource.TestCheckResourceAttrSet(resourceClusterName, "cluster_ca_certificate"),
+						resource.TestCheckResourceAttrSet(resourceClusterName, "client_token"),
 						resource.TestCheckResourceAttrSet(resourceClusterName, "dashboard_url"),
 					),
 				},
@@ -199,6 +202,45 @@ func TestAccResourceLKECluster_basic_smoke(t *testing.T) {
 	})
 }
 
+func TestAccResourceLKECluster_kubeconfigRegeneration(t *testing.T) {
+	t.Parallel()
+
+	var oldToken string
+
+	acceptance.RunTestRetry(t, 2, func(tRetry *acceptance.TRetry) {
+		clusterName := acctest.RandomWithPrefix("tf_test")
+		resource.Test(tRetry, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             acceptance.CheckLKEClusterDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.KubeconfigRegeneration(t, clusterName, k8sVersionLatest, testRegion, "initial"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet(resourceClusterName, "client_token"),
+						resource.TestCheckResourceAttrWith(resourceClusterName, "client_token", func(value string) error {
+							oldToken = value
+							return nil
+						}),
+					),
+				},
+				{
+					Config: tmpl.KubeconfigRegeneration(t, clusterName, k8sVersionLatest, testRegion, "rotated"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "kubeconfig_regeneration_trigger", "rotated"),
+						resource.TestCheckResourceAttrWith(resourceClusterName, "client_token", func(value string) error {
+							if value == oldToken {
+								return fmt.Errorf("expected client_token to change after kubeconfig regeneration")
+							}
+							return nil
+						}),
+					),
+				},
+			},
+		})
+	})
+}
+
 func TestAccResourceLKECluster_k8sUpgrade(t *testing.T) {
 	t.Parallel()
 
@@ -239,6 +281,43 @@ func TestAccResourceLKECluster_k8sUpgrade(t *testing.T) {
 	})
 }
 
+func TestAccResourceLKECluster_k8sUpgradeByPool(t *testing.T) {
+	t.Parallel()
+
+	var cluster linodego.LKECluster
+
+	acceptance.RunTestRetry(t, 2, func(tRetry *acceptance.TRetry) {
+		clusterName := acctest.RandomWithPrefix("tf_test")
+		resource.Test(tRetry, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             acceptance.CheckLKEClusterDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.Upgrade(t, clusterName, k8sVersionPrevious, testRegion),
+					Check: resource.ComposeTestCheckFunc(
+						checkLKEExists(&cluster),
+						resource.TestCheckResourceAttr(resourceClusterName, "k8s_version", k8sVersionPrevious),
+						resource.TestCheckResourceAttr(resourceClusterName, "upgrade.0.recycle_strategy", "pool"),
+					),
+				},
+				{
+					PreConfig: func() {
+						waitForAllNodesReady(t, &cluster, time.Second*5, time.Minute*5)
+					},
+					Config: tmpl.Upgrade(t, clusterName, k8sVersionLatest, testRegion),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "k8s_version", k8sVersionLatest),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.#", "2"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.nodes.0.status", "ready"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.1.nodes.0.status", "ready"),
+					),
+				},
+			},
+		})
+	})
+}
+
 func TestAccResourceLKECluster_basicUpdates(t *testing.T) {
 	t.Parallel()
 
@@ -509,6 +588,37 @@ func TestAccResourceLKECluster_controlPlane(t *testing.T) {
 	})
 }
 
+func TestAccResourceLKECluster_controlPlaneACL(t *testing.T) {
+	t.Parallel()
+
+	acceptance.RunTestRetry(t, 2, func(tRetry *acceptance.TRetry) {
+		clusterName := acctest.RandomWithPrefix("tf_test")
+		resource.Test(tRetry, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             acceptance.CheckLKEClusterDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.ControlPlaneACL(t, clusterName, k8sVersionLatest, testRegion, "10.0.0.1", true),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "control_plane.0.acl.0.enabled", "true"),
+						resource.TestCheckResourceAttr(resourceClusterName, "control_plane.0.acl.0.addresses.0.ipv4.#", "1"),
+						resource.TestCheckTypeSetElemAttr(resourceClusterName, "control_plane.0.acl.0.addresses.0.ipv4.*", "10.0.0.1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "control_plane.0.acl.0.addresses.0.ipv6.#", "1"),
+					),
+				},
+				{
+					Config: tmpl.ControlPlaneACL(t, clusterName, k8sVersionLatest, testRegion, "10.0.1.0/24", false),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "control_plane.0.acl.0.enabled", "false"),
+						resource.TestCheckTypeSetElemAttr(resourceClusterName, "control_plane.0.acl.0.addresses.0.ipv4.*", "10.0.1.0/24"),
+					),
+				},
+			},
+		})
+	})
+}
+
 func TestAccResourceLKECluster_noCount(t *testing.T) {
 	t.Parallel()
 
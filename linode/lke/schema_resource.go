@@ -3,6 +3,7 @@ package lke
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
 var resourceSchema = map[string]*schema.Schema{
@@ -48,6 +49,28 @@ var resourceSchema = map[string]*schema.Schema{
 		Sensitive:   true,
 		Description: "The Base64-encoded Kubeconfig for the cluster.",
 	},
+	"kubeconfig_regeneration_trigger": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "An arbitrary value that, when changed, regenerates the Kubeconfig for the cluster. " +
+			"The previous Kubeconfig will be invalidated.",
+	},
+	"kube_host": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The Kubernetes API server host parsed from the cluster's Kubeconfig.",
+	},
+	"cluster_ca_certificate": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The PEM-encoded cluster CA certificate parsed from the cluster's Kubeconfig.",
+	},
+	"client_token": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The client bearer token parsed from the cluster's Kubeconfig.",
+	},
 	"dashboard_url": {
 		Type:        schema.TypeString,
 		Computed:    true,
@@ -130,6 +153,27 @@ var resourceSchema = map[string]*schema.Schema{
 		Required:    true,
 		Description: "A node pool in the cluster.",
 	},
+	"upgrade": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"recycle_strategy": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  recycleStrategyPool,
+					Description: "The strategy used to recycle nodes after the control plane has been upgraded. " +
+						"`pool` recycles node pools one at a time, `cluster` recycles all node pools at once.",
+					ValidateFunc: validation.StringInSlice(
+						[]string{recycleStrategyPool, recycleStrategyCluster}, false,
+					),
+				},
+			},
+		},
+		Description: "Enables a validated Kubernetes version upgrade workflow. When defined, version upgrades " +
+			"must advance one minor version at a time and nodes are recycled according to the configured strategy.",
+	},
 	"control_plane": {
 		Type:     schema.TypeList,
 		MaxItems: 1,
@@ -143,6 +187,54 @@ var resourceSchema = map[string]*schema.Schema{
 					Optional:    true,
 					Computed:    true,
 				},
+				"acl": {
+					Type:        schema.TypeList,
+					MaxItems:    1,
+					Optional:    true,
+					Computed:    true,
+					Description: "Defines the ACL configuration for an LKE cluster's control plane.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enabled": {
+								Type:        schema.TypeBool,
+								Description: "Defines whether ACL is enabled for the cluster's control plane.",
+								Optional:    true,
+								Computed:    true,
+							},
+							"addresses": {
+								Type:        schema.TypeList,
+								MaxItems:    1,
+								Optional:    true,
+								Computed:    true,
+								Description: "A list of IP addresses and ranges allowed to access the cluster's control plane.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"ipv4": {
+											Type: schema.TypeSet,
+											Elem: &schema.Schema{
+												Type:             schema.TypeString,
+												ValidateDiagFunc: helper.SDKv2ValidateIPv4AddressOrRange,
+											},
+											Description: "A set of individual IPv4 addresses or CIDR ranges.",
+											Optional:    true,
+											Computed:    true,
+										},
+										"ipv6": {
+											Type: schema.TypeSet,
+											Elem: &schema.Schema{
+												Type:             schema.TypeString,
+												ValidateDiagFunc: helper.SDKv2ValidateIPv6AddressOrRange,
+											},
+											Description: "A set of individual IPv6 addresses or CIDR ranges.",
+											Optional:    true,
+											Computed:    true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 		Description: "Defines settings for the Kubernetes Control Plane.",
@@ -7,10 +7,13 @@ import (
 )
 
 type TemplateData struct {
-	Label            string
-	K8sVersion       string
-	HighAvailability bool
-	Region           string
+	Label             string
+	K8sVersion        string
+	HighAvailability  bool
+	Region            string
+	KubeconfigTrigger string
+	ACLEnabled        bool
+	IPv4Address       string
 }
 
 func Basic(t *testing.T, name, version, region string) string {
@@ -32,6 +35,15 @@ func ManyPools(t *testing.T, name, k8sVersion, region string) string {
 		})
 }
 
+func Upgrade(t *testing.T, name, k8sVersion, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"lke_cluster_upgrade", TemplateData{
+			Label:      name,
+			K8sVersion: k8sVersion,
+			Region:     region,
+		})
+}
+
 func ComplexPools(t *testing.T, name, version, region string) string {
 	return acceptance.ExecuteTemplate(t,
 		"lke_cluster_complex_pools", TemplateData{Label: name, K8sVersion: version, Region: region})
@@ -62,6 +74,27 @@ func ControlPlane(t *testing.T, name, version, region string, ha bool) string {
 		})
 }
 
+func KubeconfigRegeneration(t *testing.T, name, version, region, trigger string) string {
+	return acceptance.ExecuteTemplate(t,
+		"lke_cluster_kubeconfig_regeneration", TemplateData{
+			Label:             name,
+			K8sVersion:        version,
+			Region:            region,
+			KubeconfigTrigger: trigger,
+		})
+}
+
+func ControlPlaneACL(t *testing.T, name, version, region, ipv4Address string, enabled bool) string {
+	return acceptance.ExecuteTemplate(t,
+		"lke_cluster_control_plane_acl", TemplateData{
+			Label:       name,
+			K8sVersion:  version,
+			Region:      region,
+			ACLEnabled:  enabled,
+			IPv4Address: ipv4Address,
+		})
+}
+
 func NoCount(t *testing.T, name, version, region string) string {
 	return acceptance.ExecuteTemplate(t,
 		"lke_cluster_no_count", TemplateData{
@@ -0,0 +1,86 @@
+//go:build integration
+
+package lkenode_test
+
+import (
+	"context"
+	"log"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/lkenode/tmpl"
+)
+
+var (
+	k8sVersion string
+	testRegion string
+)
+
+func init() {
+	client, err := acceptance.GetTestClient()
+	if err != nil {
+		log.Fatalf("failed to get client: %s", err)
+	}
+
+	versions, err := client.ListLKEVersions(context.Background(), nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	k8sVersions := make([]string, len(versions))
+	for i, v := range versions {
+		k8sVersions[i] = v.ID
+	}
+
+	sort.Strings(k8sVersions)
+
+	if len(k8sVersions) < 1 {
+		log.Fatal("no k8s versions found")
+	}
+
+	k8sVersion = k8sVersions[len(k8sVersions)-1]
+
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"kubernetes"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccDataSourceLKENode_basic(t *testing.T) {
+	t.Parallel()
+
+	byIDName := "data.linode_lke_node.by_id"
+	byInstanceName := "data.linode_lke_node.by_instance"
+
+	acceptance.RunTestRetry(t, 2, func(tRetry *acceptance.TRetry) {
+		clusterName := acctest.RandomWithPrefix("tf_test")
+		resource.Test(tRetry, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             acceptance.CheckLKEClusterDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.DataBasic(t, clusterName, k8sVersion, testRegion),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrPair(byIDName, "id", "linode_lke_cluster.test", "pool.0.nodes.0.id"),
+						resource.TestCheckResourceAttrPair(byIDName, "instance_id", "linode_lke_cluster.test", "pool.0.nodes.0.instance_id"),
+						resource.TestCheckResourceAttrPair(byIDName, "pool_id", "linode_lke_cluster.test", "pool.0.id"),
+						resource.TestCheckResourceAttrSet(byIDName, "status"),
+						resource.TestCheckResourceAttrSet(byIDName, "public_ipv4.0"),
+						resource.TestCheckResourceAttrSet(byIDName, "private_ipv4.#"),
+						resource.TestCheckResourceAttrSet(byIDName, "ipv6"),
+
+						resource.TestCheckResourceAttrPair(byInstanceName, "id", byIDName, "id"),
+						resource.TestCheckResourceAttrPair(byInstanceName, "cluster_id", "linode_lke_cluster.test", "id"),
+						resource.TestCheckResourceAttrPair(byInstanceName, "public_ipv4.0", byIDName, "public_ipv4.0"),
+					),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,110 @@
+package lkenode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/lkenodepool"
+)
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{
+		BaseDataSource: helper.NewBaseDataSource(
+			helper.BaseDataSourceConfig{
+				Name:   "linode_lke_node",
+				Schema: &frameworkDataSourceSchema,
+			},
+		),
+	}
+}
+
+type DataSource struct {
+	helper.BaseDataSource
+}
+
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read data.linode_lke_node")
+
+	client := d.Meta.Client
+
+	var data DataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := helper.FrameworkSafeInt64ToInt(data.ClusterID.ValueInt64(), &resp.Diagnostics)
+	instanceID := helper.FrameworkSafeInt64ToInt(data.InstanceID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeID := data.ID.ValueString()
+
+	if data.ClusterID.IsNull() {
+		tflog.Trace(ctx, "lkenodepool.GetInstanceLKEClusterID(...)")
+
+		id, err := lkenodepool.GetInstanceLKEClusterID(ctx, client, instanceID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to find the LKE Cluster of instance %d", instanceID),
+				err.Error(),
+			)
+			return
+		}
+
+		clusterID = id
+		data.ClusterID = types.Int64Value(int64(id))
+	}
+
+	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
+		"cluster_id":  clusterID,
+		"node_id":     nodeID,
+		"instance_id": instanceID,
+	})
+
+	tflog.Trace(ctx, "client.ListLKENodePools(...)")
+
+	pools, err := client.ListLKENodePools(ctx, clusterID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to list pools for LKE Cluster %d", clusterID),
+			err.Error(),
+		)
+		return
+	}
+
+	node, poolID, ok := findNode(pools, nodeID, instanceID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to find node in LKE Cluster %d", clusterID),
+			"No node matched the given id or instance_id.",
+		)
+		return
+	}
+
+	ips, err := lkenodepool.GetNodeIPAddresses(ctx, client, node.InstanceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get IP addresses for LKE node %s", node.ID),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.ParseNode(ctx, poolID, *node, ips)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
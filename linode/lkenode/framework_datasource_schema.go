@@ -0,0 +1,56 @@
+package lkenode
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var frameworkDataSourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the node.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("instance_id")),
+				stringvalidator.AlsoRequires(path.MatchRoot("cluster_id")),
+			},
+		},
+		"cluster_id": schema.Int64Attribute{
+			Description: "The ID of the LKE cluster this node belongs to. " +
+				"Required when looking up the node by id, and resolved from the instance otherwise.",
+			Optional: true,
+			Computed: true,
+		},
+		"instance_id": schema.Int64Attribute{
+			Description: "The ID of the underlying Linode instance.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"pool_id": schema.Int64Attribute{
+			Description: "The ID of the Node Pool this node belongs to.",
+			Computed:    true,
+		},
+		"status": schema.StringAttribute{
+			Description: "The status of the node.",
+			Computed:    true,
+		},
+		"public_ipv4": schema.ListAttribute{
+			ElementType: types.StringType,
+			Description: "The public IPv4 addresses of the underlying Linode instance.",
+			Computed:    true,
+		},
+		"private_ipv4": schema.ListAttribute{
+			ElementType: types.StringType,
+			Description: "The private IPv4 addresses of the underlying Linode instance.",
+			Computed:    true,
+		},
+		"ipv6": schema.StringAttribute{
+			Description: "The SLAAC IPv6 address of the underlying Linode instance.",
+			Computed:    true,
+		},
+	},
+}
@@ -0,0 +1,63 @@
+package lkenode
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/lkenodepool"
+)
+
+type DataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ClusterID   types.Int64  `tfsdk:"cluster_id"`
+	InstanceID  types.Int64  `tfsdk:"instance_id"`
+	PoolID      types.Int64  `tfsdk:"pool_id"`
+	Status      types.String `tfsdk:"status"`
+	PublicIPv4  types.List   `tfsdk:"public_ipv4"`
+	PrivateIPv4 types.List   `tfsdk:"private_ipv4"`
+	IPv6        types.String `tfsdk:"ipv6"`
+}
+
+func (data *DataSourceModel) ParseNode(
+	ctx context.Context,
+	poolID int,
+	node linodego.LKENodePoolLinode,
+	ips *lkenodepool.NodeIPAddresses,
+) diag.Diagnostics {
+	var parsed lkenodepool.DataSourceNodeModel
+
+	if diags := parsed.ParseNode(ctx, node, ips); diags.HasError() {
+		return diags
+	}
+
+	data.ID = parsed.ID
+	data.InstanceID = parsed.InstanceID
+	data.PoolID = types.Int64Value(int64(poolID))
+	data.Status = parsed.Status
+	data.PublicIPv4 = parsed.PublicIPv4
+	data.PrivateIPv4 = parsed.PrivateIPv4
+	data.IPv6 = parsed.IPv6
+
+	return nil
+}
+
+// findNode returns the node matching the given node ID or instance ID
+// along with the ID of the pool it belongs to.
+func findNode(
+	pools []linodego.LKENodePool,
+	nodeID string,
+	instanceID int,
+) (*linodego.LKENodePoolLinode, int, bool) {
+	for _, pool := range pools {
+		for _, node := range pool.Linodes {
+			if (nodeID != "" && node.ID == nodeID) ||
+				(nodeID == "" && node.InstanceID == instanceID) {
+				return &node, pool.ID, true
+			}
+		}
+	}
+
+	return nil, 0, false
+}
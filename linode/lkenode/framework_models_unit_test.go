@@ -0,0 +1,59 @@
+//go:build unit
+
+package lkenode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/lkenodepool"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNode(t *testing.T) {
+	node := linodego.LKENodePoolLinode{
+		ID:         "12345-abcde",
+		InstanceID: 123,
+		Status:     linodego.LKELinodeReady,
+	}
+
+	ips := lkenodepool.NodeIPAddresses{
+		PublicIPv4:  []string{"192.0.2.1"},
+		PrivateIPv4: []string{"192.168.128.1"},
+		IPv6:        "2001:db8::1",
+	}
+
+	var data DataSourceModel
+
+	diags := data.ParseNode(context.Background(), 456, node, &ips)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, "12345-abcde", data.ID.ValueString())
+	assert.Equal(t, int64(123), data.InstanceID.ValueInt64())
+	assert.Equal(t, int64(456), data.PoolID.ValueInt64())
+	assert.Equal(t, "ready", data.Status.ValueString())
+	assert.Contains(t, data.PublicIPv4.String(), "192.0.2.1")
+	assert.Contains(t, data.PrivateIPv4.String(), "192.168.128.1")
+	assert.Equal(t, "2001:db8::1", data.IPv6.ValueString())
+}
+
+func TestFindNode(t *testing.T) {
+	pools := []linodego.LKENodePool{
+		{ID: 1, Linodes: []linodego.LKENodePoolLinode{{ID: "node-a", InstanceID: 10}}},
+		{ID: 2, Linodes: []linodego.LKENodePoolLinode{{ID: "node-b", InstanceID: 20}}},
+	}
+
+	node, poolID, ok := findNode(pools, "node-b", 0)
+	assert.True(t, ok)
+	assert.Equal(t, 2, poolID)
+	assert.Equal(t, 20, node.InstanceID)
+
+	node, poolID, ok = findNode(pools, "", 10)
+	assert.True(t, ok)
+	assert.Equal(t, 1, poolID)
+	assert.Equal(t, "node-a", node.ID)
+
+	_, _, ok = findNode(pools, "node-c", 0)
+	assert.False(t, ok)
+}
@@ -0,0 +1,22 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label      string
+	K8sVersion string
+	Region     string
+}
+
+func DataBasic(t *testing.T, label, k8sVersion, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"lke_node_data_basic", TemplateData{
+			Label:      label,
+			K8sVersion: k8sVersion,
+			Region:     region,
+		})
+}
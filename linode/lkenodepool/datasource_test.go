@@ -0,0 +1,55 @@
+//go:build integration
+
+package lkenodepool_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/lkenodepool/tmpl"
+)
+
+func TestAccDataSourceNodePool_basic(t *testing.T) {
+	t.Parallel()
+
+	if clusterID != "" {
+		t.Skip("This test provisions its own cluster and does not support LINODE_TEST_CLUSTER_ID")
+	}
+
+	byIDName := "data.linode_lke_node_pool.by_id"
+	byInstanceName := "data.linode_lke_node_pool.by_instance"
+
+	templateData := tmpl.TemplateData{
+		ClusterLabel: acctest.RandomWithPrefix("tf_test"),
+		K8sVersion:   k8sVersion,
+		Region:       testRegion,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckLKEClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.DataBasic(t, &templateData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(byIDName, "id", "linode_lke_cluster.test", "pool.0.id"),
+					resource.TestCheckResourceAttr(byIDName, "type", "g6-standard-1"),
+					resource.TestCheckResourceAttr(byIDName, "node_count", "2"),
+					resource.TestCheckResourceAttr(byIDName, "nodes.#", "2"),
+					resource.TestCheckResourceAttr(byIDName, "instance_ids.#", "2"),
+					resource.TestCheckResourceAttrSet(byIDName, "nodes.0.id"),
+					resource.TestCheckResourceAttrSet(byIDName, "nodes.0.status"),
+					resource.TestCheckResourceAttrSet(byIDName, "nodes.0.public_ipv4.0"),
+					resource.TestCheckResourceAttrSet(byIDName, "nodes.0.ipv6"),
+
+					resource.TestCheckResourceAttrPair(byInstanceName, "id", byIDName, "id"),
+					resource.TestCheckResourceAttrPair(byInstanceName, "cluster_id", "linode_lke_cluster.test", "id"),
+					resource.TestCheckResourceAttr(byInstanceName, "nodes.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,144 @@
+package lkenodepool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{
+		BaseDataSource: helper.NewBaseDataSource(
+			helper.BaseDataSourceConfig{
+				Name:   "linode_lke_node_pool",
+				Schema: &frameworkDataSourceSchema,
+			},
+		),
+	}
+}
+
+type DataSource struct {
+	helper.BaseDataSource
+}
+
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read data.linode_lke_node_pool")
+
+	client := d.Meta.Client
+
+	var data DataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := helper.FrameworkSafeInt64ToInt(data.ClusterID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ClusterID.IsNull() {
+		instanceID := helper.FrameworkSafeInt64ToInt(data.InstanceID.ValueInt64(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Trace(ctx, "GetInstanceLKEClusterID(...)")
+
+		id, err := GetInstanceLKEClusterID(ctx, client, instanceID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to find the LKE Cluster of instance %d", instanceID),
+				err.Error(),
+			)
+			return
+		}
+
+		clusterID = id
+		data.ClusterID = types.Int64Value(int64(id))
+	}
+
+	ctx = tflog.SetField(ctx, "cluster_id", clusterID)
+
+	var pool *linodego.LKENodePool
+
+	if !data.ID.IsNull() {
+		poolID := helper.FrameworkSafeInt64ToInt(data.ID.ValueInt64(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		ctx = tflog.SetField(ctx, "node_pool_id", poolID)
+		tflog.Trace(ctx, "client.GetLKENodePool(...)")
+
+		p, err := client.GetLKENodePool(ctx, clusterID, poolID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to get LKE Cluster %d Pool %d", clusterID, poolID),
+				err.Error(),
+			)
+			return
+		}
+
+		pool = p
+	} else {
+		instanceID := helper.FrameworkSafeInt64ToInt(data.InstanceID.ValueInt64(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Trace(ctx, "client.ListLKENodePools(...)")
+
+		pools, err := client.ListLKENodePools(ctx, clusterID, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to list pools for LKE Cluster %d", clusterID),
+				err.Error(),
+			)
+			return
+		}
+
+		p, err := FindNodePoolByInstanceID(pools, instanceID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to find pool for LKE Cluster %d", clusterID),
+				err.Error(),
+			)
+			return
+		}
+
+		pool = p
+	}
+
+	nodeIPs := make(map[int]*NodeIPAddresses, len(pool.Linodes))
+
+	for _, node := range pool.Linodes {
+		ips, err := GetNodeIPAddresses(ctx, client, node.InstanceID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to get IP addresses for LKE node %s", node.ID),
+				err.Error(),
+			)
+			return
+		}
+
+		nodeIPs[node.InstanceID] = ips
+	}
+
+	resp.Diagnostics.Append(data.ParseNodePool(ctx, pool, nodeIPs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,101 @@
+package lkenodepool
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var frameworkDataSourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "The ID of the Node Pool.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Int64{
+				int64validator.ExactlyOneOf(path.MatchRoot("instance_id")),
+				int64validator.AlsoRequires(path.MatchRoot("cluster_id")),
+			},
+		},
+		"cluster_id": schema.Int64Attribute{
+			Description: "The ID of the LKE cluster this Node Pool belongs to. " +
+				"Required when looking up the Node Pool by id, and resolved from the instance otherwise.",
+			Optional: true,
+			Computed: true,
+		},
+		"instance_id": schema.Int64Attribute{
+			Description: "The ID of a Linode instance in the Node Pool, used to look up the Node Pool.",
+			Optional:    true,
+		},
+		"node_count": schema.Int64Attribute{
+			Description: "The number of nodes in the Node Pool.",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "A Linode Type for all of the nodes in the Node Pool.",
+			Computed:    true,
+		},
+		"tags": schema.SetAttribute{
+			ElementType: types.StringType,
+			Description: "An array of tags applied to this object. Tags are for organizational purposes only.",
+			Computed:    true,
+		},
+		"instance_ids": schema.ListAttribute{
+			ElementType: types.Int64Type,
+			Description: "The IDs of the underlying Linode instances of all nodes in the Node Pool.",
+			Computed:    true,
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"autoscaler": schema.ListNestedBlock{
+			Description: "The autoscaler configuration of the Node Pool, if autoscaling is enabled.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"min": schema.Int64Attribute{
+						Description: "The minimum number of nodes to autoscale to.",
+						Computed:    true,
+					},
+					"max": schema.Int64Attribute{
+						Description: "The maximum number of nodes to autoscale to.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"nodes": schema.ListNestedBlock{
+			Description: "The nodes in the Node Pool.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the node.",
+						Computed:    true,
+					},
+					"instance_id": schema.Int64Attribute{
+						Description: "The ID of the underlying Linode instance.",
+						Computed:    true,
+					},
+					"status": schema.StringAttribute{
+						Description: "The status of the node.",
+						Computed:    true,
+					},
+					"public_ipv4": schema.ListAttribute{
+						ElementType: types.StringType,
+						Description: "The public IPv4 addresses of the underlying Linode instance.",
+						Computed:    true,
+					},
+					"private_ipv4": schema.ListAttribute{
+						ElementType: types.StringType,
+						Description: "The private IPv4 addresses of the underlying Linode instance.",
+						Computed:    true,
+					},
+					"ipv6": schema.StringAttribute{
+						Description: "The SLAAC IPv6 address of the underlying Linode instance.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	},
+}
@@ -158,3 +158,106 @@ func (pool *NodePoolModel) getLKENodePoolAutoscaler(count int, diags *diag.Diagn
 	}
 	return &autoscaler
 }
+
+type DataSourceModel struct {
+	ID          types.Int64               `tfsdk:"id"`
+	ClusterID   types.Int64               `tfsdk:"cluster_id"`
+	InstanceID  types.Int64               `tfsdk:"instance_id"`
+	Count       types.Int64               `tfsdk:"node_count"`
+	Type        types.String              `tfsdk:"type"`
+	Tags        types.Set                 `tfsdk:"tags"`
+	InstanceIDs types.List                `tfsdk:"instance_ids"`
+	Autoscaler  []NodePoolAutoscalerModel `tfsdk:"autoscaler"`
+	Nodes       []DataSourceNodeModel     `tfsdk:"nodes"`
+}
+
+type DataSourceNodeModel struct {
+	ID          types.String `tfsdk:"id"`
+	InstanceID  types.Int64  `tfsdk:"instance_id"`
+	Status      types.String `tfsdk:"status"`
+	PublicIPv4  types.List   `tfsdk:"public_ipv4"`
+	PrivateIPv4 types.List   `tfsdk:"private_ipv4"`
+	IPv6        types.String `tfsdk:"ipv6"`
+}
+
+func (data *DataSourceModel) ParseNodePool(
+	ctx context.Context,
+	p *linodego.LKENodePool,
+	nodeIPs map[int]*NodeIPAddresses,
+) diag.Diagnostics {
+	data.ID = types.Int64Value(int64(p.ID))
+	data.Count = types.Int64Value(int64(p.Count))
+	data.Type = types.StringValue(p.Type)
+
+	tags, diags := types.SetValueFrom(ctx, types.StringType, p.Tags)
+	if diags.HasError() {
+		return diags
+	}
+	data.Tags = tags
+
+	data.Autoscaler = nil
+	if p.Autoscaler.Enabled {
+		data.Autoscaler = []NodePoolAutoscalerModel{
+			{
+				Min: types.Int64Value(int64(p.Autoscaler.Min)),
+				Max: types.Int64Value(int64(p.Autoscaler.Max)),
+			},
+		}
+	}
+
+	instanceIDs := make([]int64, len(p.Linodes))
+	nodes := make([]DataSourceNodeModel, len(p.Linodes))
+
+	for i, n := range p.Linodes {
+		instanceIDs[i] = int64(n.InstanceID)
+
+		var node DataSourceNodeModel
+
+		diags := node.ParseNode(ctx, n, nodeIPs[n.InstanceID])
+		if diags.HasError() {
+			return diags
+		}
+
+		nodes[i] = node
+	}
+
+	data.Nodes = nodes
+
+	instanceIDList, diags := types.ListValueFrom(ctx, types.Int64Type, instanceIDs)
+	if diags.HasError() {
+		return diags
+	}
+	data.InstanceIDs = instanceIDList
+
+	return nil
+}
+
+func (node *DataSourceNodeModel) ParseNode(
+	ctx context.Context,
+	n linodego.LKENodePoolLinode,
+	ips *NodeIPAddresses,
+) diag.Diagnostics {
+	node.ID = types.StringValue(n.ID)
+	node.InstanceID = types.Int64Value(int64(n.InstanceID))
+	node.Status = types.StringValue(string(n.Status))
+
+	if ips == nil {
+		ips = &NodeIPAddresses{}
+	}
+
+	publicIPv4, diags := types.ListValueFrom(ctx, types.StringType, ips.PublicIPv4)
+	if diags.HasError() {
+		return diags
+	}
+	node.PublicIPv4 = publicIPv4
+
+	privateIPv4, diags := types.ListValueFrom(ctx, types.StringType, ips.PrivateIPv4)
+	if diags.HasError() {
+		return diags
+	}
+	node.PrivateIPv4 = privateIPv4
+
+	node.IPv6 = types.StringValue(ips.IPv6)
+
+	return nil
+}
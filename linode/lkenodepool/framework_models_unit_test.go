@@ -117,3 +117,60 @@ func createNodePoolModel() *NodePoolModel {
 	}
 	return &nodePoolModel
 }
+
+func TestParseNodePoolDataSource(t *testing.T) {
+	lkeNodePool := linodego.LKENodePool{
+		ID:    123,
+		Count: 2,
+		Type:  "g6-standard-2",
+		Linodes: []linodego.LKENodePoolLinode{
+			{InstanceID: 1, ID: "linode123", Status: "ready"},
+			{InstanceID: 2, ID: "linode124", Status: "not_ready"},
+		},
+		Tags: []string{"production"},
+	}
+
+	nodeIPs := map[int]*NodeIPAddresses{
+		1: {
+			PublicIPv4:  []string{"192.0.2.1"},
+			PrivateIPv4: []string{"192.168.128.1"},
+			IPv6:        "2001:db8::1",
+		},
+	}
+
+	var data DataSourceModel
+
+	diags := data.ParseNodePool(context.Background(), &lkeNodePool, nodeIPs)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, int64(123), data.ID.ValueInt64())
+	assert.Equal(t, int64(2), data.Count.ValueInt64())
+	assert.Equal(t, "g6-standard-2", data.Type.ValueString())
+	assert.Len(t, data.Autoscaler, 0)
+	assert.Len(t, data.InstanceIDs.Elements(), 2)
+
+	assert.Len(t, data.Nodes, 2)
+	assert.Equal(t, "linode123", data.Nodes[0].ID.ValueString())
+	assert.Equal(t, "ready", data.Nodes[0].Status.ValueString())
+	assert.Contains(t, data.Nodes[0].PublicIPv4.String(), "192.0.2.1")
+	assert.Contains(t, data.Nodes[0].PrivateIPv4.String(), "192.168.128.1")
+	assert.Equal(t, "2001:db8::1", data.Nodes[0].IPv6.ValueString())
+
+	// Nodes without known IPs should have empty IP attributes
+	assert.Len(t, data.Nodes[1].PublicIPv4.Elements(), 0)
+	assert.Equal(t, "", data.Nodes[1].IPv6.ValueString())
+}
+
+func TestFindNodePoolByInstanceID(t *testing.T) {
+	pools := []linodego.LKENodePool{
+		{ID: 1, Linodes: []linodego.LKENodePoolLinode{{InstanceID: 10}}},
+		{ID: 2, Linodes: []linodego.LKENodePoolLinode{{InstanceID: 20}, {InstanceID: 21}}},
+	}
+
+	pool, err := FindNodePoolByInstanceID(pools, 21)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, pool.ID)
+
+	_, err = FindNodePoolByInstanceID(pools, 30)
+	assert.Error(t, err)
+}
@@ -3,10 +3,12 @@ package lkenodepool
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
 func WaitForNodePoolReady(
@@ -51,3 +53,93 @@ func WaitForNodePoolReady(
 		}
 	}
 }
+
+// NodeIPAddresses contains the IP addresses assigned to the
+// underlying Linode instance of an LKE node.
+type NodeIPAddresses struct {
+	PublicIPv4  []string
+	PrivateIPv4 []string
+	IPv6        string
+}
+
+// GetNodeIPAddresses retrieves the IP addresses assigned to the given LKE node instance.
+func GetNodeIPAddresses(ctx context.Context, client *linodego.Client, instanceID int) (*NodeIPAddresses, error) {
+	result := NodeIPAddresses{
+		PublicIPv4:  make([]string, 0),
+		PrivateIPv4: make([]string, 0),
+	}
+
+	// Nodes that are still being provisioned may not have an instance yet
+	if instanceID == 0 {
+		return &result, nil
+	}
+
+	tflog.Trace(ctx, "client.GetInstanceIPAddresses(...)", map[string]any{
+		"instance_id": instanceID,
+	})
+
+	ips, err := client.GetInstanceIPAddresses(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IP addresses for instance %d: %w", instanceID, err)
+	}
+
+	if ips.IPv4 != nil {
+		for _, ip := range ips.IPv4.Public {
+			result.PublicIPv4 = append(result.PublicIPv4, ip.Address)
+		}
+
+		for _, ip := range ips.IPv4.Private {
+			result.PrivateIPv4 = append(result.PrivateIPv4, ip.Address)
+		}
+	}
+
+	if ips.IPv6 != nil && ips.IPv6.SLAAC != nil {
+		result.IPv6 = ips.IPv6.SLAAC.Address
+	}
+
+	return &result, nil
+}
+
+// FindNodePoolByInstanceID returns the node pool in the given list that
+// contains a node with the given instance ID.
+func FindNodePoolByInstanceID(pools []linodego.LKENodePool, instanceID int) (*linodego.LKENodePool, error) {
+	for _, pool := range pools {
+		for _, node := range pool.Linodes {
+			if node.InstanceID == instanceID {
+				return &pool, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no node pool found containing instance %d", instanceID)
+}
+
+// NOTE: lke_cluster_id is not yet supported by linodego.
+type nodeInstance struct {
+	LKEClusterID int `json:"lke_cluster_id"`
+}
+
+// GetInstanceLKEClusterID returns the ID of the LKE cluster
+// the given Linode instance is a node of.
+func GetInstanceLKEClusterID(ctx context.Context, client *linodego.Client, instanceID int) (int, error) {
+	tflog.Trace(ctx, "GET linode/instances/{id}", map[string]any{
+		"instance_id": instanceID,
+	})
+
+	instance, err := helper.DoAPIRequest[nodeInstance](
+		ctx,
+		client,
+		http.MethodGet,
+		fmt.Sprintf("linode/instances/%d", instanceID),
+		nil,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get instance %d: %w", instanceID, err)
+	}
+
+	if instance.LKEClusterID == 0 {
+		return 0, fmt.Errorf("instance %d isn't a node of an LKE cluster", instanceID)
+	}
+
+	return instance.LKEClusterID, nil
+}
@@ -0,0 +1,43 @@
+//go:build unit
+
+package lkenodepool
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetInstanceLKEClusterID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/linode/instances/123":
+			w.Write([]byte(`{"id": 123, "lke_cluster_id": 456}`))
+		case "/v4/linode/instances/789":
+			w.Write([]byte(`{"id": 789, "lke_cluster_id": null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	clusterID, err := GetInstanceLKEClusterID(context.Background(), &client, 123)
+	assert.NoError(t, err)
+	assert.Equal(t, 456, clusterID)
+
+	// Instances which aren't LKE nodes can't be resolved to a cluster
+	_, err = GetInstanceLKEClusterID(context.Background(), &client, 789)
+	assert.ErrorContains(t, err, "isn't a node of an LKE cluster")
+
+	_, err = GetInstanceLKEClusterID(context.Background(), &client, 1)
+	assert.Error(t, err)
+}
@@ -21,3 +21,7 @@ type TemplateData struct {
 func Generate(t *testing.T, data *TemplateData) string {
 	return acceptance.ExecuteTemplate(t, "nodepool_template", *data)
 }
+
+func DataBasic(t *testing.T, data *TemplateData) string {
+	return acceptance.ExecuteTemplate(t, "lke_node_pool_data_basic", *data)
+}
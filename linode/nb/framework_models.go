@@ -31,6 +31,40 @@ type NodeBalancerModel struct {
 	Transfer           types.List        `tfsdk:"transfer"`
 	Tags               types.Set         `tfsdk:"tags"`
 	Firewalls          types.List        `tfsdk:"firewalls"`
+	Configs            []NBConfigModel   `tfsdk:"config"`
+}
+
+// NBConfigModel describes an inline config block of the NodeBalancer resource.
+type NBConfigModel struct {
+	ID             types.Int64         `tfsdk:"id"`
+	Port           types.Int64         `tfsdk:"port"`
+	Protocol       types.String        `tfsdk:"protocol"`
+	ProxyProtocol  types.String        `tfsdk:"proxy_protocol"`
+	Algorithm      types.String        `tfsdk:"algorithm"`
+	Stickiness     types.String        `tfsdk:"stickiness"`
+	Check          types.String        `tfsdk:"check"`
+	CheckInterval  types.Int64         `tfsdk:"check_interval"`
+	CheckTimeout   types.Int64         `tfsdk:"check_timeout"`
+	CheckAttempts  types.Int64         `tfsdk:"check_attempts"`
+	CheckPath      types.String        `tfsdk:"check_path"`
+	CheckBody      types.String        `tfsdk:"check_body"`
+	CheckPassive   types.Bool          `tfsdk:"check_passive"`
+	CipherSuite    types.String        `tfsdk:"cipher_suite"`
+	SSLCert        types.String        `tfsdk:"ssl_cert"`
+	SSLKey         types.String        `tfsdk:"ssl_key"`
+	SSLCommonName  types.String        `tfsdk:"ssl_commonname"`
+	SSLFingerprint types.String        `tfsdk:"ssl_fingerprint"`
+	Nodes          []NBConfigNodeModel `tfsdk:"node"`
+}
+
+// NBConfigNodeModel describes an inline node block of a NodeBalancer config.
+type NBConfigNodeModel struct {
+	ID      types.Int64  `tfsdk:"id"`
+	Label   types.String `tfsdk:"label"`
+	Address types.String `tfsdk:"address"`
+	Weight  types.Int64  `tfsdk:"weight"`
+	Mode    types.String `tfsdk:"mode"`
+	Status  types.String `tfsdk:"status"`
 }
 
 type nbModelV0 struct {
@@ -111,6 +145,260 @@ func (data *NodeBalancerModel) CopyFrom(other NodeBalancerModel, preserveKnown b
 	data.Firewalls = helper.KeepOrUpdateValue(data.Firewalls, other.Firewalls, preserveKnown)
 }
 
+// FlattenConfigs populates the inline config blocks from the given configs and
+// their nodes. Existing blocks keep their position and are matched by ID, or by
+// port and address when the ID is not known yet. Configs and nodes that are not
+// declared are appended so that they show up as drift.
+func (data *NodeBalancerModel) FlattenConfigs(
+	configs []linodego.NodeBalancerConfig,
+	nodes map[int][]linodego.NodeBalancerNode,
+	preserveKnown bool,
+) {
+	result := make([]NBConfigModel, 0, len(configs))
+	flattened := make(map[int]bool, len(configs))
+
+	for _, current := range data.Configs {
+		config := findConfig(configs, current)
+		if config == nil {
+			continue
+		}
+
+		current.flattenConfig(config, nodes[config.ID], preserveKnown)
+		result = append(result, current)
+		flattened[config.ID] = true
+	}
+
+	for i := range configs {
+		if flattened[configs[i].ID] {
+			continue
+		}
+
+		var config NBConfigModel
+		config.flattenConfig(&configs[i], nodes[configs[i].ID], false)
+		result = append(result, config)
+	}
+
+	data.Configs = result
+}
+
+func (c *NBConfigModel) flattenConfig(
+	config *linodego.NodeBalancerConfig,
+	nodes []linodego.NodeBalancerNode,
+	preserveKnown bool,
+) {
+	c.ID = helper.KeepOrUpdateInt64(c.ID, int64(config.ID), preserveKnown)
+	c.Port = helper.KeepOrUpdateInt64(c.Port, int64(config.Port), preserveKnown)
+	c.Protocol = helper.KeepOrUpdateString(c.Protocol, string(config.Protocol), preserveKnown)
+	c.ProxyProtocol = helper.KeepOrUpdateString(c.ProxyProtocol, string(config.ProxyProtocol), preserveKnown)
+	c.Algorithm = helper.KeepOrUpdateString(c.Algorithm, string(config.Algorithm), preserveKnown)
+	c.Stickiness = helper.KeepOrUpdateString(c.Stickiness, string(config.Stickiness), preserveKnown)
+	c.Check = helper.KeepOrUpdateString(c.Check, string(config.Check), preserveKnown)
+	c.CheckInterval = helper.KeepOrUpdateInt64(c.CheckInterval, int64(config.CheckInterval), preserveKnown)
+	c.CheckTimeout = helper.KeepOrUpdateInt64(c.CheckTimeout, int64(config.CheckTimeout), preserveKnown)
+	c.CheckAttempts = helper.KeepOrUpdateInt64(c.CheckAttempts, int64(config.CheckAttempts), preserveKnown)
+	c.CheckPath = helper.KeepOrUpdateString(c.CheckPath, config.CheckPath, preserveKnown)
+	c.CheckBody = helper.KeepOrUpdateString(c.CheckBody, config.CheckBody, preserveKnown)
+	c.CheckPassive = helper.KeepOrUpdateBool(c.CheckPassive, config.CheckPassive, preserveKnown)
+	c.CipherSuite = helper.KeepOrUpdateString(c.CipherSuite, string(config.CipherSuite), preserveKnown)
+	c.SSLCommonName = helper.KeepOrUpdateString(c.SSLCommonName, config.SSLCommonName, preserveKnown)
+	c.SSLFingerprint = helper.KeepOrUpdateString(c.SSLFingerprint, config.SSLFingerprint, preserveKnown)
+
+	// The certificate and key are redacted by the API, so the declared values are kept.
+	if c.SSLCert.IsUnknown() {
+		c.SSLCert = types.StringNull()
+	}
+	if c.SSLKey.IsUnknown() {
+		c.SSLKey = types.StringNull()
+	}
+
+	result := make([]NBConfigNodeModel, 0, len(nodes))
+	flattened := make(map[int]bool, len(nodes))
+
+	for _, current := range c.Nodes {
+		node := findNode(nodes, current)
+		if node == nil {
+			continue
+		}
+
+		current.flattenNode(node, preserveKnown)
+		result = append(result, current)
+		flattened[node.ID] = true
+	}
+
+	for i := range nodes {
+		if flattened[nodes[i].ID] {
+			continue
+		}
+
+		var node NBConfigNodeModel
+		node.flattenNode(&nodes[i], false)
+		result = append(result, node)
+	}
+
+	c.Nodes = result
+}
+
+func (n *NBConfigNodeModel) flattenNode(node *linodego.NodeBalancerNode, preserveKnown bool) {
+	n.ID = helper.KeepOrUpdateInt64(n.ID, int64(node.ID), preserveKnown)
+	n.Label = helper.KeepOrUpdateString(n.Label, node.Label, preserveKnown)
+	n.Address = helper.KeepOrUpdateString(n.Address, node.Address, preserveKnown)
+	n.Weight = helper.KeepOrUpdateInt64(n.Weight, int64(node.Weight), preserveKnown)
+	n.Mode = helper.KeepOrUpdateString(n.Mode, string(node.Mode), preserveKnown)
+	n.Status = helper.KeepOrUpdateString(n.Status, node.Status, preserveKnown)
+}
+
+// CopyComputedFrom fills the unknown computed values of a planned config from
+// the config in state with the same port, so unchanged configs don't show a diff.
+func (c *NBConfigModel) CopyComputedFrom(other NBConfigModel) {
+	c.ID = helper.KeepOrUpdateValue(c.ID, other.ID, true)
+	c.Protocol = helper.KeepOrUpdateValue(c.Protocol, other.Protocol, true)
+	c.ProxyProtocol = helper.KeepOrUpdateValue(c.ProxyProtocol, other.ProxyProtocol, true)
+	c.Algorithm = helper.KeepOrUpdateValue(c.Algorithm, other.Algorithm, true)
+	c.Stickiness = helper.KeepOrUpdateValue(c.Stickiness, other.Stickiness, true)
+	c.Check = helper.KeepOrUpdateValue(c.Check, other.Check, true)
+	c.CheckInterval = helper.KeepOrUpdateValue(c.CheckInterval, other.CheckInterval, true)
+	c.CheckTimeout = helper.KeepOrUpdateValue(c.CheckTimeout, other.CheckTimeout, true)
+	c.CheckAttempts = helper.KeepOrUpdateValue(c.CheckAttempts, other.CheckAttempts, true)
+	c.CheckPath = helper.KeepOrUpdateValue(c.CheckPath, other.CheckPath, true)
+	c.CheckBody = helper.KeepOrUpdateValue(c.CheckBody, other.CheckBody, true)
+	c.CheckPassive = helper.KeepOrUpdateValue(c.CheckPassive, other.CheckPassive, true)
+	c.CipherSuite = helper.KeepOrUpdateValue(c.CipherSuite, other.CipherSuite, true)
+
+	// The certificate details only change along with the certificate itself.
+	if c.SSLCert.Equal(other.SSLCert) && c.SSLKey.Equal(other.SSLKey) {
+		c.SSLCommonName = helper.KeepOrUpdateValue(c.SSLCommonName, other.SSLCommonName, true)
+		c.SSLFingerprint = helper.KeepOrUpdateValue(c.SSLFingerprint, other.SSLFingerprint, true)
+	}
+
+	for i := range c.Nodes {
+		for _, node := range other.Nodes {
+			if c.Nodes[i].Address.Equal(node.Address) {
+				c.Nodes[i].ID = helper.KeepOrUpdateValue(c.Nodes[i].ID, node.ID, true)
+				c.Nodes[i].Weight = helper.KeepOrUpdateValue(c.Nodes[i].Weight, node.Weight, true)
+				c.Nodes[i].Mode = helper.KeepOrUpdateValue(c.Nodes[i].Mode, node.Mode, true)
+				c.Nodes[i].Status = helper.KeepOrUpdateValue(c.Nodes[i].Status, node.Status, true)
+				break
+			}
+		}
+	}
+}
+
+// GetCreateOptions returns the options to create this config along with all of its nodes.
+func (c *NBConfigModel) GetCreateOptions(diags *diag.Diagnostics) linodego.NodeBalancerConfigCreateOptions {
+	opts := linodego.NodeBalancerConfigCreateOptions{
+		Port:          helper.FrameworkSafeInt64ToInt(c.Port.ValueInt64(), diags),
+		Protocol:      linodego.ConfigProtocol(c.Protocol.ValueString()),
+		ProxyProtocol: linodego.ConfigProxyProtocol(c.ProxyProtocol.ValueString()),
+		Algorithm:     linodego.ConfigAlgorithm(c.Algorithm.ValueString()),
+		Stickiness:    linodego.ConfigStickiness(c.Stickiness.ValueString()),
+		Check:         linodego.ConfigCheck(c.Check.ValueString()),
+		CheckInterval: helper.FrameworkSafeInt64ToInt(c.CheckInterval.ValueInt64(), diags),
+		CheckAttempts: helper.FrameworkSafeInt64ToInt(c.CheckAttempts.ValueInt64(), diags),
+		CheckTimeout:  helper.FrameworkSafeInt64ToInt(c.CheckTimeout.ValueInt64(), diags),
+		CheckPath:     c.CheckPath.ValueString(),
+		CheckBody:     c.CheckBody.ValueString(),
+		CipherSuite:   linodego.ConfigCipher(c.CipherSuite.ValueString()),
+		SSLCert:       c.SSLCert.ValueString(),
+		SSLKey:        c.SSLKey.ValueString(),
+		Nodes:         make([]linodego.NodeBalancerNodeCreateOptions, len(c.Nodes)),
+	}
+
+	if !c.CheckPassive.IsNull() && !c.CheckPassive.IsUnknown() {
+		opts.CheckPassive = c.CheckPassive.ValueBoolPointer()
+	}
+
+	for i, node := range c.Nodes {
+		opts.Nodes[i] = node.getCreateOptions(diags)
+	}
+
+	return opts
+}
+
+// GetRebuildOptions returns the options to rebuild this config with all of its
+// nodes. Nodes are matched to the current nodes of the config by address so
+// that they are updated in place rather than recreated.
+func (c *NBConfigModel) GetRebuildOptions(
+	current []linodego.NodeBalancerNode,
+	diags *diag.Diagnostics,
+) linodego.NodeBalancerConfigRebuildOptions {
+	createOpts := c.GetCreateOptions(diags)
+
+	opts := linodego.NodeBalancerConfigRebuildOptions{
+		Port:          createOpts.Port,
+		Protocol:      createOpts.Protocol,
+		ProxyProtocol: createOpts.ProxyProtocol,
+		Algorithm:     createOpts.Algorithm,
+		Stickiness:    createOpts.Stickiness,
+		Check:         createOpts.Check,
+		CheckInterval: createOpts.CheckInterval,
+		CheckAttempts: createOpts.CheckAttempts,
+		CheckPath:     createOpts.CheckPath,
+		CheckBody:     createOpts.CheckBody,
+		CheckPassive:  createOpts.CheckPassive,
+		CheckTimeout:  createOpts.CheckTimeout,
+		CipherSuite:   createOpts.CipherSuite,
+		SSLCert:       createOpts.SSLCert,
+		SSLKey:        createOpts.SSLKey,
+		Nodes:         make([]linodego.NodeBalancerConfigRebuildNodeOptions, len(createOpts.Nodes)),
+	}
+
+	for i, node := range createOpts.Nodes {
+		opts.Nodes[i].NodeBalancerNodeCreateOptions = node
+
+		for _, existing := range current {
+			if existing.Address == node.Address {
+				opts.Nodes[i].ID = existing.ID
+				break
+			}
+		}
+	}
+
+	return opts
+}
+
+func (n *NBConfigNodeModel) getCreateOptions(diags *diag.Diagnostics) linodego.NodeBalancerNodeCreateOptions {
+	return linodego.NodeBalancerNodeCreateOptions{
+		Label:   n.Label.ValueString(),
+		Address: n.Address.ValueString(),
+		Weight:  helper.FrameworkSafeInt64ToInt(n.Weight.ValueInt64(), diags),
+		Mode:    linodego.NodeMode(n.Mode.ValueString()),
+	}
+}
+
+func findConfig(configs []linodego.NodeBalancerConfig, model NBConfigModel) *linodego.NodeBalancerConfig {
+	for i, config := range configs {
+		if !model.ID.IsUnknown() && !model.ID.IsNull() {
+			if int64(config.ID) == model.ID.ValueInt64() {
+				return &configs[i]
+			}
+			continue
+		}
+
+		if int64(config.Port) == model.Port.ValueInt64() {
+			return &configs[i]
+		}
+	}
+
+	return nil
+}
+
+func findNode(nodes []linodego.NodeBalancerNode, model NBConfigNodeModel) *linodego.NodeBalancerNode {
+	for i, node := range nodes {
+		if !model.ID.IsUnknown() && !model.ID.IsNull() {
+			if int64(node.ID) == model.ID.ValueInt64() {
+				return &nodes[i]
+			}
+			continue
+		}
+
+		if node.Address == model.Address.ValueString() {
+			return &nodes[i]
+		}
+	}
+
+	return nil
+}
+
 func parseNBFirewalls(
 	ctx context.Context,
 	firewalls []linodego.Firewall,
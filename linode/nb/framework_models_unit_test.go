@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
@@ -113,3 +114,202 @@ func TestUpgradeResourceStateValue(t *testing.T) {
 		assert.Empty(t, result)
 	})
 }
+
+func TestFlattenConfigs(t *testing.T) {
+	configs := []linodego.NodeBalancerConfig{
+		{ID: 1, Port: 80, Protocol: linodego.ProtocolHTTP, Algorithm: linodego.AlgorithmRoundRobin},
+		{ID: 2, Port: 443, Protocol: linodego.ProtocolHTTPS, SSLCert: "<REDACTED>", SSLCommonName: "example.com"},
+		{ID: 3, Port: 8080, Protocol: linodego.ProtocolTCP},
+	}
+
+	nodes := map[int][]linodego.NodeBalancerNode{
+		1: {
+			{ID: 10, Address: "192.168.0.1:80", Label: "a", Weight: 50, Mode: "accept", Status: "UP"},
+			{ID: 11, Address: "192.168.0.2:80", Label: "b", Weight: 50, Mode: "accept", Status: "UP"},
+		},
+		2: {
+			{ID: 20, Address: "192.168.0.1:443", Label: "a", Weight: 100, Mode: "drain"},
+		},
+	}
+
+	data := NodeBalancerModel{
+		Configs: []NBConfigModel{
+			{
+				ID:      types.Int64Value(2),
+				Port:    types.Int64Value(443),
+				SSLCert: types.StringValue("cert"),
+				SSLKey:  types.StringValue("key"),
+			},
+			{
+				ID:   types.Int64Value(1),
+				Port: types.Int64Value(80),
+				Nodes: []NBConfigNodeModel{
+					{ID: types.Int64Value(11), Address: types.StringValue("192.168.0.2:80")},
+				},
+			},
+			{
+				ID:   types.Int64Value(4),
+				Port: types.Int64Value(9000),
+			},
+		},
+	}
+
+	data.FlattenConfigs(configs, nodes, false)
+
+	assert.Len(t, data.Configs, 3)
+
+	// Declared configs keep their order, the deleted one is dropped and the
+	// undeclared one is appended.
+	assert.Equal(t, types.Int64Value(2), data.Configs[0].ID)
+	assert.Equal(t, types.StringValue("https"), data.Configs[0].Protocol)
+	assert.Equal(t, types.StringValue("cert"), data.Configs[0].SSLCert)
+	assert.Equal(t, types.StringValue("key"), data.Configs[0].SSLKey)
+	assert.Equal(t, types.StringValue("example.com"), data.Configs[0].SSLCommonName)
+	assert.Len(t, data.Configs[0].Nodes, 1)
+	assert.Equal(t, types.StringValue("drain"), data.Configs[0].Nodes[0].Mode)
+
+	assert.Equal(t, types.Int64Value(1), data.Configs[1].ID)
+	assert.Equal(t, types.StringValue("roundrobin"), data.Configs[1].Algorithm)
+	assert.Len(t, data.Configs[1].Nodes, 2)
+	assert.Equal(t, types.Int64Value(11), data.Configs[1].Nodes[0].ID)
+	assert.Equal(t, types.Int64Value(10), data.Configs[1].Nodes[1].ID)
+
+	assert.Equal(t, types.Int64Value(3), data.Configs[2].ID)
+	assert.Equal(t, types.Int64Value(8080), data.Configs[2].Port)
+	assert.Empty(t, data.Configs[2].Nodes)
+	assert.True(t, data.Configs[2].SSLCert.IsNull())
+}
+
+func TestFlattenConfigsPreserveKnown(t *testing.T) {
+	configs := []linodego.NodeBalancerConfig{
+		{ID: 1, Port: 80, Protocol: linodego.ProtocolHTTP, Check: linodego.CheckNone},
+	}
+
+	nodes := map[int][]linodego.NodeBalancerNode{
+		1: {{ID: 10, Address: "192.168.0.1:80", Label: "a", Weight: 100, Mode: "accept", Status: "unknown"}},
+	}
+
+	data := NodeBalancerModel{
+		Configs: []NBConfigModel{
+			{
+				ID:       types.Int64Unknown(),
+				Port:     types.Int64Value(80),
+				Protocol: types.StringValue("http"),
+				Check:    types.StringUnknown(),
+				SSLCert:  types.StringNull(),
+				Nodes: []NBConfigNodeModel{
+					{
+						ID:      types.Int64Unknown(),
+						Label:   types.StringValue("a"),
+						Address: types.StringValue("192.168.0.1:80"),
+						Weight:  types.Int64Unknown(),
+						Status:  types.StringUnknown(),
+					},
+				},
+			},
+		},
+	}
+
+	data.FlattenConfigs(configs, nodes, true)
+
+	assert.Len(t, data.Configs, 1)
+	assert.Equal(t, types.Int64Value(1), data.Configs[0].ID)
+	assert.Equal(t, types.StringValue("none"), data.Configs[0].Check)
+	assert.True(t, data.Configs[0].SSLCert.IsNull())
+	assert.Equal(t, types.Int64Value(10), data.Configs[0].Nodes[0].ID)
+	assert.Equal(t, types.Int64Value(100), data.Configs[0].Nodes[0].Weight)
+	assert.Equal(t, types.StringValue("unknown"), data.Configs[0].Nodes[0].Status)
+}
+
+func TestConfigGetRebuildOptions(t *testing.T) {
+	config := NBConfigModel{
+		Port:         types.Int64Value(80),
+		Protocol:     types.StringValue("http"),
+		Algorithm:    types.StringUnknown(),
+		CheckPassive: types.BoolUnknown(),
+		Nodes: []NBConfigNodeModel{
+			{Label: types.StringValue("a"), Address: types.StringValue("192.168.0.1:80"), Weight: types.Int64Value(10)},
+			{Label: types.StringValue("c"), Address: types.StringValue("192.168.0.3:80"), Mode: types.StringValue("backup")},
+		},
+	}
+
+	current := []linodego.NodeBalancerNode{
+		{ID: 10, Address: "192.168.0.1:80"},
+		{ID: 11, Address: "192.168.0.2:80"},
+	}
+
+	var diags diag.Diagnostics
+
+	opts := config.GetRebuildOptions(current, &diags)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 80, opts.Port)
+	assert.Equal(t, linodego.ProtocolHTTP, opts.Protocol)
+	assert.Empty(t, opts.Algorithm)
+	assert.Nil(t, opts.CheckPassive)
+
+	assert.Len(t, opts.Nodes, 2)
+	assert.Equal(t, 10, opts.Nodes[0].ID)
+	assert.Equal(t, 10, opts.Nodes[0].Weight)
+	assert.Equal(t, 0, opts.Nodes[1].ID)
+	assert.Equal(t, linodego.NodeMode("backup"), opts.Nodes[1].Mode)
+}
+
+func TestConfigCopyComputedFrom(t *testing.T) {
+	state := NBConfigModel{
+		ID:             types.Int64Value(1),
+		Port:           types.Int64Value(443),
+		Algorithm:      types.StringValue("leastconn"),
+		SSLCert:        types.StringValue("old"),
+		SSLCommonName:  types.StringValue("old.example.com"),
+		SSLFingerprint: types.StringValue("AA:BB"),
+		Nodes: []NBConfigNodeModel{
+			{ID: types.Int64Value(10), Address: types.StringValue("192.168.0.1:443"), Weight: types.Int64Value(5)},
+		},
+	}
+
+	plan := NBConfigModel{
+		ID:             types.Int64Unknown(),
+		Port:           types.Int64Value(443),
+		Algorithm:      types.StringUnknown(),
+		SSLCert:        types.StringValue("new"),
+		SSLCommonName:  types.StringUnknown(),
+		SSLFingerprint: types.StringUnknown(),
+		Nodes: []NBConfigNodeModel{
+			{ID: types.Int64Unknown(), Address: types.StringValue("192.168.0.1:443"), Weight: types.Int64Value(7)},
+			{ID: types.Int64Unknown(), Address: types.StringValue("192.168.0.2:443"), Weight: types.Int64Unknown()},
+		},
+	}
+
+	plan.CopyComputedFrom(state)
+
+	assert.Equal(t, types.Int64Value(1), plan.ID)
+	assert.Equal(t, types.StringValue("leastconn"), plan.Algorithm)
+	assert.True(t, plan.SSLCommonName.IsUnknown())
+	assert.True(t, plan.SSLFingerprint.IsUnknown())
+	assert.Equal(t, types.Int64Value(10), plan.Nodes[0].ID)
+	assert.Equal(t, types.Int64Value(7), plan.Nodes[0].Weight)
+	assert.True(t, plan.Nodes[1].ID.IsUnknown())
+	assert.True(t, plan.Nodes[1].Weight.IsUnknown())
+}
+
+func TestValidateConfigs(t *testing.T) {
+	node := func(address string) NBConfigNodeModel {
+		return NBConfigNodeModel{Address: types.StringValue(address)}
+	}
+
+	assert.NoError(t, validateConfigs([]NBConfigModel{
+		{Port: types.Int64Value(80), Nodes: []NBConfigNodeModel{node("192.168.0.1:80"), node("192.168.0.2:80")}},
+		{Port: types.Int64Value(443), Nodes: []NBConfigNodeModel{node("192.168.0.1:80")}},
+		{Port: types.Int64Unknown()},
+	}))
+
+	assert.ErrorContains(t, validateConfigs([]NBConfigModel{
+		{Port: types.Int64Value(80)},
+		{Port: types.Int64Value(80)},
+	}), "port 80")
+
+	assert.ErrorContains(t, validateConfigs([]NBConfigModel{
+		{Port: types.Int64Value(80), Nodes: []NBConfigNodeModel{node("192.168.0.1:80"), node("192.168.0.1:80")}},
+	}), "192.168.0.1:80")
+}
@@ -3,19 +3,25 @@ package nb
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
-var _ resource.ResourceWithUpgradeState = &Resource{}
+var (
+	_ resource.ResourceWithUpgradeState   = &Resource{}
+	_ resource.ResourceWithModifyPlan     = &Resource{}
+	_ resource.ResourceWithValidateConfig = &Resource{}
+)
 
 func NewResource() resource.Resource {
 	return &Resource{
@@ -78,8 +84,18 @@ func (r *Resource) Create(
 		}
 	}
 
+	for _, config := range data.Configs {
+		configOpts := config.GetCreateOptions(&resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		createOpts.Configs = append(createOpts.Configs, &configOpts)
+	}
+
 	tflog.Debug(ctx, "client.CreateNodeBalancer(...)", map[string]any{
-		"options": createOpts,
+		"label":   createOpts.Label,
+		"region":  createOpts.Region,
+		"configs": len(createOpts.Configs),
 	})
 
 	nodebalancer, err := client.CreateNodeBalancer(ctx, createOpts)
@@ -104,6 +120,13 @@ func (r *Resource) Create(
 		return
 	}
 
+	if len(data.Configs) > 0 {
+		refreshConfigs(ctx, client, nodebalancer.ID, &data, true, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// IDs should always be overridden during creation (see #1085)
 	// TODO: Remove when Crossplane empty string ID issue is resolved
 	data.ID = types.StringValue(strconv.Itoa(nodebalancer.ID))
@@ -169,6 +192,15 @@ func (r *Resource) Read(
 		return
 	}
 
+	// The topology is only tracked when it is declared inline, so that configs
+	// managed by linode_nodebalancer_config resources aren't reported as drift.
+	if len(data.Configs) > 0 {
+		refreshConfigs(ctx, client, id, &data, false, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -241,7 +273,25 @@ func (r *Resource) Update(
 		}
 
 		resp.Diagnostics.Append(plan.FlattenNodeBalancer(ctx, nodeBalancer, firewalls, true)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if len(plan.Configs) > 0 {
+		if !reflect.DeepEqual(plan.Configs, state.Configs) {
+			applyConfigs(ctx, client, id, plan.Configs, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		refreshConfigs(ctx, client, id, &plan, true, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
+
 	plan.CopyFrom(state, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -287,6 +337,81 @@ func (r *Resource) Delete(
 	}
 }
 
+func (r *Resource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var configs types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("config"), &configs)...)
+	if resp.Diagnostics.HasError() || configs.IsNull() || configs.IsUnknown() {
+		return
+	}
+
+	var declared []NBConfigModel
+	if diags := configs.ElementsAs(ctx, &declared, false); diags.HasError() {
+		// Nested blocks that can't be decoded yet are validated on a later pass.
+		return
+	}
+
+	if err := validateConfigs(declared); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("config"), "Invalid NodeBalancer config", err.Error())
+	}
+}
+
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var planned, current types.List
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("config"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("config"), &current)...)
+	if resp.Diagnostics.HasError() || planned.IsUnknown() {
+		return
+	}
+
+	if len(planned.Elements()) == 0 {
+		if len(current.Elements()) > 0 {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("config"),
+				"NodeBalancer Configs Will Be Left In Place",
+				"All config blocks were removed, so the existing configs and nodes of this NodeBalancer "+
+					"are no longer managed by this resource and will not be deleted. Remove them with "+
+					"the Linode API or manage them with linode_nodebalancer_config and linode_nodebalancer_node.",
+			)
+		}
+		return
+	}
+
+	var plan, state []NBConfigModel
+
+	resp.Diagnostics.Append(planned.ElementsAs(ctx, &plan, false)...)
+	resp.Diagnostics.Append(current.ElementsAs(ctx, &state, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Computed values of configs and nodes that are still declared are carried
+	// over from state, matched by port and address rather than by position.
+	for i := range plan {
+		for _, config := range state {
+			if plan[i].Port.Equal(config.Port) {
+				plan[i].CopyComputedFrom(config)
+				break
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("config"), plan)...)
+}
+
 func (r *Resource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		0: {
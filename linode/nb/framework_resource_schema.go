@@ -137,6 +137,180 @@ var frameworkResourceSchema = schema.Schema{
 			},
 		},
 	},
+	Blocks: map[string]schema.Block{
+		"config": schema.ListNestedBlock{
+			Description: "A port configuration of this NodeBalancer. When at least one config block is declared, " +
+				"the NodeBalancer's configs and nodes are managed by this resource as a whole.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: configResourceAttributes,
+				Blocks: map[string]schema.Block{
+					"node": schema.ListNestedBlock{
+						Description: "A backend node of this config.",
+						NestedObject: schema.NestedBlockObject{
+							Attributes: nodeResourceAttributes,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var configResourceAttributes = map[string]schema.Attribute{
+	"id": schema.Int64Attribute{
+		Description: "The ID of the NodeBalancer config.",
+		Computed:    true,
+	},
+	"port": schema.Int64Attribute{
+		Description: "The TCP port this config is for. Ports must be unique across the configs of a NodeBalancer.",
+		Required:    true,
+		Validators: []validator.Int64{
+			int64validator.Between(1, 65535),
+		},
+	},
+	"protocol": schema.StringAttribute{
+		Description: "The protocol this port is configured to serve. If this is set to https you must " +
+			"include an ssl_cert and an ssl_key.",
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("http", "https", "tcp"),
+		},
+	},
+	"proxy_protocol": schema.StringAttribute{
+		Description: "The version of ProxyProtocol to use for the underlying NodeBalancer. " +
+			"This requires protocol to be `tcp`. Valid values are `none`, `v1`, and `v2`.",
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("none", "v1", "v2"),
+		},
+	},
+	"algorithm": schema.StringAttribute{
+		Description: "What algorithm this NodeBalancer should use for routing traffic to backends: roundrobin, " +
+			"leastconn, source",
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("roundrobin", "leastconn", "source"),
+		},
+	},
+	"stickiness": schema.StringAttribute{
+		Description: "Controls how session stickiness is handled on this port: 'none', 'table', 'http_cookie'",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("none", "table", "http_cookie"),
+		},
+	},
+	"check": schema.StringAttribute{
+		Description: "The type of check to perform against backends to ensure they are serving requests.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("none", "connection", "http", "http_body"),
+		},
+	},
+	"check_interval": schema.Int64Attribute{
+		Description: "How often, in seconds, to check that backends are up and serving requests.",
+		Optional:    true,
+		Computed:    true,
+	},
+	"check_timeout": schema.Int64Attribute{
+		Description: "How long, in seconds, to wait for a check attempt before considering it failed. (1-30)",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.Int64{
+			int64validator.Between(1, 30),
+		},
+	},
+	"check_attempts": schema.Int64Attribute{
+		Description: "How many times to attempt a check before considering a backend to be down. (1-30)",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.Int64{
+			int64validator.Between(1, 30),
+		},
+	},
+	"check_path": schema.StringAttribute{
+		Description: "The URL path to check on each backend.",
+		Optional:    true,
+		Computed:    true,
+	},
+	"check_body": schema.StringAttribute{
+		Description: "This value must be present in the response body of the check in order for it to pass.",
+		Optional:    true,
+		Computed:    true,
+	},
+	"check_passive": schema.BoolAttribute{
+		Description: "If true, any response from this backend with a 5xx status code will be enough for it to " +
+			"be considered unhealthy and taken out of rotation.",
+		Optional: true,
+		Computed: true,
+	},
+	"cipher_suite": schema.StringAttribute{
+		Description: "What ciphers to use for SSL connections served by this NodeBalancer.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("recommended", "legacy"),
+		},
+	},
+	"ssl_cert": schema.StringAttribute{
+		Description: "The certificate this port is serving. This is not returned by the API.",
+		Optional:    true,
+		Sensitive:   true,
+	},
+	"ssl_key": schema.StringAttribute{
+		Description: "The private key corresponding to this port's certificate. This is not returned by the API.",
+		Optional:    true,
+		Sensitive:   true,
+	},
+	"ssl_commonname": schema.StringAttribute{
+		Description: "The common name derived from the SSL certificate assigned to this config.",
+		Computed:    true,
+	},
+	"ssl_fingerprint": schema.StringAttribute{
+		Description: "The fingerprint derived from the SSL certificate assigned to this config.",
+		Computed:    true,
+	},
+}
+
+var nodeResourceAttributes = map[string]schema.Attribute{
+	"id": schema.Int64Attribute{
+		Description: "The ID of the NodeBalancer node.",
+		Computed:    true,
+	},
+	"label": schema.StringAttribute{
+		Description: "The label for this node. This is for display purposes only.",
+		Required:    true,
+	},
+	"address": schema.StringAttribute{
+		Description: "The private IP Address and port (IP:PORT) where this backend can be reached. " +
+			"Addresses must be unique within a config.",
+		Required: true,
+	},
+	"weight": schema.Int64Attribute{
+		Description: "Used when picking a backend to serve a request and is not pinned to a single backend " +
+			"yet. Nodes with a higher weight will receive more traffic. (1-255)",
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int64{
+			int64validator.Between(1, 255),
+		},
+	},
+	"mode": schema.StringAttribute{
+		Description: "The mode this NodeBalancer should use when sending traffic to this backend.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("accept", "reject", "drain", "backup"),
+		},
+	},
+	"status": schema.StringAttribute{
+		Description: "The current status of this node, based on the configured checks of its config.",
+		Computed:    true,
+	},
 }
 
 var resourceNodebalancerV0 = schema.Schema{
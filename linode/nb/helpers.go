@@ -0,0 +1,182 @@
+package nb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+)
+
+// getNodeBalancerTopology returns all configs of the given NodeBalancer along
+// with the nodes of each config, keyed by config ID.
+func getNodeBalancerTopology(
+	ctx context.Context,
+	client *linodego.Client,
+	nodeBalancerID int,
+) ([]linodego.NodeBalancerConfig, map[int][]linodego.NodeBalancerNode, error) {
+	tflog.Trace(ctx, "client.ListNodeBalancerConfigs(...)")
+
+	configs, err := client.ListNodeBalancerConfigs(ctx, nodeBalancerID, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list configs of nodebalancer %d: %w", nodeBalancerID, err)
+	}
+
+	nodes := make(map[int][]linodego.NodeBalancerNode, len(configs))
+
+	for _, config := range configs {
+		tflog.Trace(ctx, "client.ListNodeBalancerNodes(...)", map[string]any{
+			"config_id": config.ID,
+		})
+
+		configNodes, err := client.ListNodeBalancerNodes(ctx, nodeBalancerID, config.ID, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf(
+				"failed to list nodes of nodebalancer %d config %d: %w", nodeBalancerID, config.ID, err,
+			)
+		}
+
+		nodes[config.ID] = configNodes
+	}
+
+	return configs, nodes, nil
+}
+
+// applyConfigs reconciles the configs of the given NodeBalancer with the declared
+// ones. Configs are matched by port; each existing config is rebuilt together with
+// its full node list in a single request, new configs are created with their nodes,
+// and configs that are no longer declared are deleted last.
+func applyConfigs(
+	ctx context.Context,
+	client *linodego.Client,
+	nodeBalancerID int,
+	declared []NBConfigModel,
+	diags *diag.Diagnostics,
+) {
+	configs, nodes, err := getNodeBalancerTopology(ctx, client, nodeBalancerID)
+	if err != nil {
+		diags.AddError("Failed to get NodeBalancer configs", err.Error())
+		return
+	}
+
+	byPort := make(map[int64]linodego.NodeBalancerConfig, len(configs))
+	for _, config := range configs {
+		byPort[int64(config.Port)] = config
+	}
+
+	keep := make(map[int]bool, len(declared))
+
+	for _, config := range declared {
+		current, ok := byPort[config.Port.ValueInt64()]
+		if !ok {
+			createOpts := config.GetCreateOptions(diags)
+			if diags.HasError() {
+				return
+			}
+
+			tflog.Debug(ctx, "client.CreateNodeBalancerConfig(...)", map[string]any{
+				"port": createOpts.Port,
+			})
+
+			if _, err := client.CreateNodeBalancerConfig(ctx, nodeBalancerID, createOpts); err != nil {
+				diags.AddError(
+					fmt.Sprintf("Failed to create config for port %d on NodeBalancer %d", createOpts.Port, nodeBalancerID),
+					err.Error(),
+				)
+				return
+			}
+			continue
+		}
+
+		keep[current.ID] = true
+
+		rebuildOpts := config.GetRebuildOptions(nodes[current.ID], diags)
+		if diags.HasError() {
+			return
+		}
+
+		tflog.Debug(ctx, "client.RebuildNodeBalancerConfig(...)", map[string]any{
+			"config_id": current.ID,
+		})
+
+		if _, err := client.RebuildNodeBalancerConfig(ctx, nodeBalancerID, current.ID, rebuildOpts); err != nil {
+			diags.AddError(
+				fmt.Sprintf("Failed to rebuild config %d on NodeBalancer %d", current.ID, nodeBalancerID),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	for _, config := range configs {
+		if keep[config.ID] {
+			continue
+		}
+
+		tflog.Debug(ctx, "client.DeleteNodeBalancerConfig(...)", map[string]any{
+			"config_id": config.ID,
+		})
+
+		if err := client.DeleteNodeBalancerConfig(ctx, nodeBalancerID, config.ID); err != nil {
+			diags.AddError(
+				fmt.Sprintf("Failed to delete config %d on NodeBalancer %d", config.ID, nodeBalancerID),
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// refreshConfigs reads back the topology of the given NodeBalancer into the
+// inline config blocks of the model.
+func refreshConfigs(
+	ctx context.Context,
+	client *linodego.Client,
+	nodeBalancerID int,
+	data *NodeBalancerModel,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	configs, nodes, err := getNodeBalancerTopology(ctx, client, nodeBalancerID)
+	if err != nil {
+		diags.AddError("Failed to get NodeBalancer configs", err.Error())
+		return
+	}
+
+	data.FlattenConfigs(configs, nodes, preserveKnown)
+}
+
+// validateConfigs checks that the ports of the declared configs and the node
+// addresses within each config are unique.
+func validateConfigs(configs []NBConfigModel) error {
+	ports := make(map[int64]bool, len(configs))
+
+	for _, config := range configs {
+		if config.Port.IsUnknown() || config.Port.IsNull() {
+			continue
+		}
+
+		port := config.Port.ValueInt64()
+		if ports[port] {
+			return fmt.Errorf("port %d is declared in more than one config block", port)
+		}
+		ports[port] = true
+
+		addresses := make(map[string]bool, len(config.Nodes))
+
+		for _, node := range config.Nodes {
+			if node.Address.IsUnknown() || node.Address.IsNull() {
+				continue
+			}
+
+			address := node.Address.ValueString()
+			if addresses[address] {
+				return fmt.Errorf("address %s is declared in more than one node block of port %d", address, port)
+			}
+			addresses[address] = true
+		}
+	}
+
+	return nil
+}
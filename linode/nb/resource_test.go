@@ -195,6 +195,96 @@ func TestAccResourceNodeBalancer_firewall(t *testing.T) {
 	})
 }
 
+func TestAccResourceNodeBalancer_inlineConfigs(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_nodebalancer.foobar"
+	nodebalancerName := acctest.RandomWithPrefix("tf_test")
+	rootPass := acctest.RandString(12)
+
+	var nodeBalancer linodego.NodeBalancer
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             checkNodeBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.InlineConfigs(t, nodebalancerName, testRegion, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					checkNodeBalancerLoaded(resName, &nodeBalancer),
+					resource.TestCheckResourceAttr(resName, "config.#", "2"),
+					resource.TestCheckResourceAttrSet(resName, "config.0.id"),
+					resource.TestCheckResourceAttr(resName, "config.0.port", "80"),
+					resource.TestCheckResourceAttr(resName, "config.0.protocol", "http"),
+					resource.TestCheckResourceAttr(resName, "config.0.check", "connection"),
+					resource.TestCheckResourceAttrSet(resName, "config.0.algorithm"),
+					resource.TestCheckResourceAttr(resName, "config.0.node.#", "2"),
+					resource.TestCheckResourceAttrSet(resName, "config.0.node.0.id"),
+					resource.TestCheckResourceAttr(resName, "config.0.node.0.label", "backend-0"),
+					resource.TestCheckResourceAttr(resName, "config.0.node.0.weight", "50"),
+					resource.TestCheckResourceAttrSet(resName, "config.0.node.0.status"),
+					resource.TestCheckResourceAttr(resName, "config.1.port", "8080"),
+					resource.TestCheckResourceAttr(resName, "config.1.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resName, "config.1.node.#", "1"),
+				),
+			},
+			{
+				Config: tmpl.InlineConfigsUpdates(t, nodebalancerName, testRegion, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					checkNodeBalancerLoaded(resName, &nodeBalancer),
+					resource.TestCheckResourceAttr(resName, "config.#", "2"),
+					resource.TestCheckResourceAttr(resName, "config.0.port", "80"),
+					resource.TestCheckResourceAttr(resName, "config.0.algorithm", "leastconn"),
+					resource.TestCheckResourceAttr(resName, "config.0.node.#", "1"),
+					resource.TestCheckResourceAttr(resName, "config.0.node.0.label", "backend-1"),
+					resource.TestCheckResourceAttr(resName, "config.0.node.0.weight", "100"),
+					resource.TestCheckResourceAttr(resName, "config.0.node.0.mode", "drain"),
+					resource.TestCheckResourceAttr(resName, "config.1.port", "9090"),
+					resource.TestCheckResourceAttr(resName, "config.1.node.#", "2"),
+					checkNodeBalancerConfigCount(&nodeBalancer, 2),
+				),
+			},
+			{
+				// Removing a config outside of Terraform must be detected as drift
+				PreConfig: func() {
+					client, err := acceptance.GetTestClient()
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					configs, err := client.ListNodeBalancerConfigs(context.Background(), nodeBalancer.ID, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					for _, config := range configs {
+						if config.Port != 9090 {
+							continue
+						}
+
+						if err := client.DeleteNodeBalancerConfig(context.Background(), nodeBalancer.ID, config.ID); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				Config:             tmpl.InlineConfigsUpdates(t, nodebalancerName, testRegion, rootPass),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: tmpl.InlineConfigsUpdates(t, nodebalancerName, testRegion, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "config.#", "2"),
+					resource.TestCheckResourceAttr(resName, "config.1.port", "9090"),
+					resource.TestCheckResourceAttr(resName, "config.1.node.#", "2"),
+					checkNodeBalancerConfigCount(&nodeBalancer, 2),
+				),
+			},
+		},
+	})
+}
+
 func TestLinodeNodeBalancer_UpgradeV0(t *testing.T) {
 	t.Parallel()
 
@@ -330,3 +420,45 @@ func checkNodeBalancerDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func checkNodeBalancerConfigCount(nodeBalancer *linodego.NodeBalancer, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
+
+		configs, err := client.ListNodeBalancerConfigs(context.Background(), nodeBalancer.ID, nil)
+		if err != nil {
+			return fmt.Errorf("Error listing configs of NodeBalancer %d: %s", nodeBalancer.ID, err)
+		}
+
+		if len(configs) != expected {
+			return fmt.Errorf("expected %d configs on NodeBalancer %d, got %d", expected, nodeBalancer.ID, len(configs))
+		}
+
+		return nil
+	}
+}
+
+func checkNodeBalancerLoaded(name string, nodeBalancer *linodego.NodeBalancer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", name)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
+		}
+
+		found, err := client.GetNodeBalancer(context.Background(), id)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of NodeBalancer %s: %s", rs.Primary.Attributes["label"], err)
+		}
+
+		*nodeBalancer = *found
+
+		return nil
+	}
+}
@@ -7,8 +7,9 @@ import (
 )
 
 type TemplateData struct {
-	Label  string
-	Region string
+	Label    string
+	Region   string
+	RootPass string
 }
 
 func Basic(t *testing.T, nodebalancer, region string) string {
@@ -50,3 +51,21 @@ func Firewall(t *testing.T, nodebalancer, region string) string {
 			Region: region,
 		})
 }
+
+func InlineConfigs(t *testing.T, nodebalancer, region, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"nodebalancer_inline_configs", TemplateData{
+			Label:    nodebalancer,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
+
+func InlineConfigsUpdates(t *testing.T, nodebalancer, region, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"nodebalancer_inline_configs_updates", TemplateData{
+			Label:    nodebalancer,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
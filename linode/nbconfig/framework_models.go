@@ -1,11 +1,21 @@
 package nbconfig
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
 type DataSourceModel struct {
@@ -80,3 +90,359 @@ func parseNodeStatus(
 	}
 	return &resultList, nil
 }
+
+// ResourceModel describes the Terraform resource data model to match the
+// resource schema.
+type ResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	NodeBalancerID     types.Int64    `tfsdk:"nodebalancer_id"`
+	Protocol           types.String   `tfsdk:"protocol"`
+	ProxyProtocol      types.String   `tfsdk:"proxy_protocol"`
+	Port               types.Int64    `tfsdk:"port"`
+	CheckInterval      types.Int64    `tfsdk:"check_interval"`
+	CheckTimeout       types.Int64    `tfsdk:"check_timeout"`
+	CheckAttempts      types.Int64    `tfsdk:"check_attempts"`
+	Algorithm          types.String   `tfsdk:"algorithm"`
+	Stickiness         types.String   `tfsdk:"stickiness"`
+	Check              types.String   `tfsdk:"check"`
+	CheckPath          types.String   `tfsdk:"check_path"`
+	CheckBody          types.String   `tfsdk:"check_body"`
+	CheckPassive       types.Bool     `tfsdk:"check_passive"`
+	CipherSuite        types.String   `tfsdk:"cipher_suite"`
+	SSLCommonName      types.String   `tfsdk:"ssl_commonname"`
+	SSLFingerprint     types.String   `tfsdk:"ssl_fingerprint"`
+	SSLNotAfter        types.String   `tfsdk:"ssl_not_after"`
+	SSLIssuer          types.String   `tfsdk:"ssl_issuer"`
+	SSLSANs            types.List     `tfsdk:"ssl_sans"`
+	SSLRenewBeforeDays types.Int64    `tfsdk:"ssl_renew_before_days"`
+	SSLExpiring        types.Bool     `tfsdk:"ssl_expiring"`
+	SSLCert            types.String   `tfsdk:"ssl_cert"`
+	SSLKey             types.String   `tfsdk:"ssl_key"`
+	WaitForHealthy     types.Bool     `tfsdk:"wait_for_healthy"`
+	MinHealthyNodes    types.Int64    `tfsdk:"min_healthy_nodes"`
+	NodeStatus         types.List     `tfsdk:"node_status"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (data *ResourceModel) FlattenNodeBalancerConfig(
+	config *linodego.NodeBalancerConfig,
+	preserveKnown bool,
+) diag.Diagnostics {
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(config.ID), preserveKnown)
+	data.NodeBalancerID = helper.KeepOrUpdateInt64(data.NodeBalancerID, int64(config.NodeBalancerID), preserveKnown)
+
+	// The protocol is accepted in any case but always returned in lowercase.
+	if data.Protocol.IsUnknown() || !strings.EqualFold(data.Protocol.ValueString(), string(config.Protocol)) {
+		data.Protocol = helper.KeepOrUpdateString(data.Protocol, string(config.Protocol), preserveKnown)
+	}
+
+	data.ProxyProtocol = helper.KeepOrUpdateString(data.ProxyProtocol, string(config.ProxyProtocol), preserveKnown)
+	data.Port = helper.KeepOrUpdateInt64(data.Port, int64(config.Port), preserveKnown)
+	data.CheckInterval = helper.KeepOrUpdateInt64(data.CheckInterval, int64(config.CheckInterval), preserveKnown)
+	data.CheckTimeout = helper.KeepOrUpdateInt64(data.CheckTimeout, int64(config.CheckTimeout), preserveKnown)
+	data.CheckAttempts = helper.KeepOrUpdateInt64(data.CheckAttempts, int64(config.CheckAttempts), preserveKnown)
+	data.Algorithm = helper.KeepOrUpdateString(data.Algorithm, string(config.Algorithm), preserveKnown)
+	data.Stickiness = helper.KeepOrUpdateString(data.Stickiness, string(config.Stickiness), preserveKnown)
+	data.Check = helper.KeepOrUpdateString(data.Check, string(config.Check), preserveKnown)
+	data.CheckPath = helper.KeepOrUpdateString(data.CheckPath, config.CheckPath, preserveKnown)
+	data.CheckBody = helper.KeepOrUpdateString(data.CheckBody, config.CheckBody, preserveKnown)
+	data.CheckPassive = helper.KeepOrUpdateBool(data.CheckPassive, config.CheckPassive, preserveKnown)
+	data.CipherSuite = helper.KeepOrUpdateString(data.CipherSuite, string(config.CipherSuite), preserveKnown)
+
+	// The certificate metadata is parsed from ssl_cert, which the API doesn't
+	// return, so it is only cleared when the certificate is removed or replaced.
+	if !preserveKnown && (config.SSLFingerprint == "" ||
+		(data.SSLFingerprint.ValueString() != "" && data.SSLFingerprint.ValueString() != config.SSLFingerprint)) {
+		data.clearSSLMetadata()
+	}
+
+	data.SSLFingerprint = helper.KeepOrUpdateString(data.SSLFingerprint, config.SSLFingerprint, preserveKnown)
+	data.SSLCommonName = helper.KeepOrUpdateString(data.SSLCommonName, config.SSLCommonName, preserveKnown)
+
+	nodeStatus, diags := parseNodeStatus(config.NodesStatus)
+	if diags.HasError() {
+		return diags
+	}
+	data.NodeStatus = helper.KeepOrUpdateValue(data.NodeStatus, *nodeStatus, preserveKnown)
+
+	return nil
+}
+
+func (data *ResourceModel) GetCreateOptions(diags *diag.Diagnostics) linodego.NodeBalancerConfigCreateOptions {
+	createOpts := linodego.NodeBalancerConfigCreateOptions{
+		Algorithm:     linodego.ConfigAlgorithm(data.Algorithm.ValueString()),
+		Check:         linodego.ConfigCheck(data.Check.ValueString()),
+		Stickiness:    linodego.ConfigStickiness(data.Stickiness.ValueString()),
+		CheckAttempts: helper.FrameworkSafeInt64ToInt(data.CheckAttempts.ValueInt64(), diags),
+		CheckBody:     data.CheckBody.ValueString(),
+		CheckInterval: helper.FrameworkSafeInt64ToInt(data.CheckInterval.ValueInt64(), diags),
+		CheckPath:     data.CheckPath.ValueString(),
+		CheckTimeout:  helper.FrameworkSafeInt64ToInt(data.CheckTimeout.ValueInt64(), diags),
+		CipherSuite:   linodego.ConfigCipher(data.CipherSuite.ValueString()),
+		Port:          helper.FrameworkSafeInt64ToInt(data.Port.ValueInt64(), diags),
+		Protocol:      linodego.ConfigProtocol(strings.ToLower(data.Protocol.ValueString())),
+		ProxyProtocol: linodego.ConfigProxyProtocol(data.ProxyProtocol.ValueString()),
+		SSLCert:       data.SSLCert.ValueString(),
+		SSLKey:        data.SSLKey.ValueString(),
+	}
+
+	if !data.CheckPassive.IsUnknown() && !data.CheckPassive.IsNull() {
+		createOpts.CheckPassive = data.CheckPassive.ValueBoolPointer()
+	}
+
+	return createOpts
+}
+
+func (data *ResourceModel) GetUpdateOptions(diags *diag.Diagnostics) linodego.NodeBalancerConfigUpdateOptions {
+	return linodego.NodeBalancerConfigUpdateOptions(data.GetCreateOptions(diags))
+}
+
+// setSSLMetadata stores the metadata parsed from the configured certificate.
+func (data *ResourceModel) setSSLMetadata(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cert := data.SSLCert.ValueString()
+	if cert == "" {
+		data.clearSSLMetadata()
+		return nil
+	}
+
+	chain, err := parseCertificateChain(cert)
+	if err != nil {
+		diags.AddAttributeError(path.Root("ssl_cert"), "Invalid SSL Certificate", err.Error())
+		return diags
+	}
+
+	data.SSLNotAfter = types.StringValue(chain[0].NotAfter.Format(time.RFC3339))
+	data.SSLIssuer = types.StringValue(chain[0].Issuer.String())
+	data.SSLSANs, diags = types.ListValueFrom(ctx, types.StringType, certificateSANs(chain[0]))
+
+	return diags
+}
+
+// resolveSSLMetadata parses the certificate metadata that wasn't known at plan
+// time because ssl_cert was only known after apply.
+func (data *ResourceModel) resolveSSLMetadata(ctx context.Context) diag.Diagnostics {
+	if data.SSLNotAfter.IsUnknown() {
+		if diags := data.setSSLMetadata(ctx); diags.HasError() {
+			return diags
+		}
+	}
+
+	if data.SSLExpiring.IsUnknown() {
+		data.setSSLExpiring(time.Now())
+	}
+
+	return nil
+}
+
+// setSSLExpiring sets whether the certificate expires within ssl_renew_before_days
+// of now. It is null when no certificate is set.
+func (data *ResourceModel) setSSLExpiring(now time.Time) {
+	days := data.SSLRenewBeforeDays
+
+	switch {
+	case data.SSLNotAfter.IsUnknown() || days.IsUnknown():
+		data.SSLExpiring = types.BoolUnknown()
+	case data.SSLNotAfter.IsNull():
+		data.SSLExpiring = types.BoolNull()
+	case days.IsNull():
+		data.SSLExpiring = types.BoolValue(false)
+	default:
+		notAfter, err := time.Parse(time.RFC3339, data.SSLNotAfter.ValueString())
+		data.SSLExpiring = types.BoolValue(err == nil && expiresWithin(notAfter, int(days.ValueInt64()), now))
+	}
+}
+
+func (data *ResourceModel) clearSSLMetadata() {
+	data.SSLNotAfter = types.StringNull()
+	data.SSLIssuer = types.StringNull()
+	data.SSLSANs = types.ListNull(types.StringType)
+}
+
+func (data *ResourceModel) unknownSSLMetadata() {
+	data.SSLNotAfter = types.StringUnknown()
+	data.SSLIssuer = types.StringUnknown()
+	data.SSLSANs = types.ListUnknown(types.StringType)
+}
+
+// resourceModelV1 describes the state of linode_nodebalancer_config written by
+// the SDKv2 implementation of the resource. Version 0 states only differ in
+// the encoding of node_status.
+type resourceModelV1 struct {
+	ID                 string          `json:"id"`
+	NodeBalancerID     int64           `json:"nodebalancer_id"`
+	Protocol           string          `json:"protocol"`
+	ProxyProtocol      string          `json:"proxy_protocol"`
+	Port               int64           `json:"port"`
+	CheckInterval      int64           `json:"check_interval"`
+	CheckTimeout       int64           `json:"check_timeout"`
+	CheckAttempts      int64           `json:"check_attempts"`
+	Algorithm          string          `json:"algorithm"`
+	Stickiness         string          `json:"stickiness"`
+	Check              string          `json:"check"`
+	CheckPath          string          `json:"check_path"`
+	CheckBody          string          `json:"check_body"`
+	CheckPassive       bool            `json:"check_passive"`
+	CipherSuite        string          `json:"cipher_suite"`
+	SSLCommonName      string          `json:"ssl_commonname"`
+	SSLFingerprint     string          `json:"ssl_fingerprint"`
+	SSLNotAfter        string          `json:"ssl_not_after"`
+	SSLIssuer          string          `json:"ssl_issuer"`
+	SSLSANs            []string        `json:"ssl_sans"`
+	SSLRenewBeforeDays int64           `json:"ssl_renew_before_days"`
+	SSLCert            string          `json:"ssl_cert"`
+	SSLKey             string          `json:"ssl_key"`
+	WaitForHealthy     *bool           `json:"wait_for_healthy"`
+	MinHealthyNodes    *int64          `json:"min_healthy_nodes"`
+	NodeStatus         json.RawMessage `json:"node_status"`
+	Timeouts           *timeoutsV1     `json:"timeouts"`
+}
+
+type timeoutsV1 struct {
+	Create *string `json:"create"`
+	Update *string `json:"update"`
+}
+
+// nodeStatus decodes node_status, which was stored as a map of strings in
+// version 0 states and as a single element list in version 1 states.
+func (data *resourceModelV1) nodeStatus(version int64) (*linodego.NodeBalancerNodeStatus, error) {
+	status := &linodego.NodeBalancerNodeStatus{}
+
+	if len(data.NodeStatus) == 0 || string(data.NodeStatus) == "null" {
+		if version == 0 {
+			return status, nil
+		}
+		return nil, nil
+	}
+
+	if version > 0 {
+		var statuses []struct {
+			Up   int `json:"up"`
+			Down int `json:"down"`
+		}
+
+		if err := json.Unmarshal(data.NodeStatus, &statuses); err != nil {
+			return nil, fmt.Errorf("failed to parse node_status: %w", err)
+		}
+
+		if len(statuses) == 0 {
+			return nil, nil
+		}
+
+		status.Up, status.Down = statuses[0].Up, statuses[0].Down
+		return status, nil
+	}
+
+	var statuses map[string]string
+	if err := json.Unmarshal(data.NodeStatus, &statuses); err != nil {
+		return nil, fmt.Errorf("failed to parse node_status: %w", err)
+	}
+
+	for key, target := range map[string]*int{"up": &status.Up, "down": &status.Down} {
+		// Old versions of the state may hold empty values that default to zero.
+		if statuses[key] == "" {
+			continue
+		}
+
+		value, err := strconv.Atoi(statuses[key])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse node_status.%s: %w", key, err)
+		}
+		*target = value
+	}
+
+	return status, nil
+}
+
+// upgrade converts the prior state into the current resource model. Values the
+// SDKv2 implementation stored as empty strings or zeros are converted to nulls.
+func (data *resourceModelV1) upgrade(
+	ctx context.Context,
+	nodeStatus *linodego.NodeBalancerNodeStatus,
+) (ResourceModel, diag.Diagnostics) {
+	result := ResourceModel{
+		ID:              types.StringValue(data.ID),
+		NodeBalancerID:  types.Int64Value(data.NodeBalancerID),
+		Protocol:        types.StringValue(data.Protocol),
+		ProxyProtocol:   types.StringValue(data.ProxyProtocol),
+		Port:            types.Int64Value(data.Port),
+		CheckInterval:   types.Int64Value(data.CheckInterval),
+		CheckTimeout:    types.Int64Value(data.CheckTimeout),
+		CheckAttempts:   types.Int64Value(data.CheckAttempts),
+		Algorithm:       types.StringValue(data.Algorithm),
+		Stickiness:      types.StringValue(data.Stickiness),
+		Check:           types.StringValue(data.Check),
+		CheckPath:       types.StringValue(data.CheckPath),
+		CheckBody:       types.StringValue(data.CheckBody),
+		CheckPassive:    types.BoolValue(data.CheckPassive),
+		CipherSuite:     types.StringValue(data.CipherSuite),
+		SSLCommonName:   types.StringValue(data.SSLCommonName),
+		SSLFingerprint:  types.StringValue(data.SSLFingerprint),
+		SSLNotAfter:     helper.GetValueIfNotNull(data.SSLNotAfter),
+		SSLIssuer:       helper.GetValueIfNotNull(data.SSLIssuer),
+		SSLSANs:         types.ListNull(types.StringType),
+		SSLCert:         helper.GetValueIfNotNull(data.SSLCert),
+		SSLKey:          helper.GetValueIfNotNull(data.SSLKey),
+		WaitForHealthy:  types.BoolValue(false),
+		MinHealthyNodes: types.Int64Value(1),
+		NodeStatus:      types.ListNull(statusObjectType),
+		Timeouts:        upgradeTimeouts(data.Timeouts),
+	}
+
+	if data.SSLRenewBeforeDays > 0 {
+		result.SSLRenewBeforeDays = types.Int64Value(data.SSLRenewBeforeDays)
+	} else {
+		result.SSLRenewBeforeDays = types.Int64Null()
+	}
+
+	if data.WaitForHealthy != nil {
+		result.WaitForHealthy = types.BoolValue(*data.WaitForHealthy)
+	}
+
+	if data.MinHealthyNodes != nil {
+		result.MinHealthyNodes = types.Int64Value(*data.MinHealthyNodes)
+	}
+
+	var diags diag.Diagnostics
+
+	if !result.SSLNotAfter.IsNull() {
+		sans := data.SSLSANs
+		if sans == nil {
+			sans = []string{}
+		}
+
+		result.SSLSANs, diags = types.ListValueFrom(ctx, types.StringType, sans)
+		if diags.HasError() {
+			return result, diags
+		}
+	}
+
+	if nodeStatus != nil {
+		status, diags := parseNodeStatus(nodeStatus)
+		if diags.HasError() {
+			return result, diags
+		}
+		result.NodeStatus = *status
+	}
+
+	return result, nil
+}
+
+func upgradeTimeouts(prior *timeoutsV1) timeouts.Value {
+	attrTypes := map[string]attr.Type{
+		"create": types.StringType,
+		"update": types.StringType,
+	}
+
+	if prior == nil {
+		return timeouts.Value{Object: types.ObjectNull(attrTypes)}
+	}
+
+	return timeouts.Value{
+		Object: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"create": types.StringPointerValue(prior.Create),
+			"update": types.StringPointerValue(prior.Update),
+		}),
+	}
+}
@@ -0,0 +1,179 @@
+//go:build unit
+
+package nbconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func upgradeTestState(t *testing.T, version int64, rawState string) ResourceModel {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	req := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	}
+	resp := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	upgradeResourceState(ctx, req, &resp, version)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var data ResourceModel
+	require.False(t, resp.State.Get(ctx, &data).HasError())
+
+	return data
+}
+
+func TestUpgradeResourceStateV0(t *testing.T) {
+	data := upgradeTestState(t, 0, `{
+		"id": "123",
+		"nodebalancer_id": 456,
+		"port": 80,
+		"protocol": "http",
+		"node_status": {"down": "13", "up": "37"}
+	}`)
+
+	assert.Equal(t, types.StringValue("123"), data.ID)
+	assert.Equal(t, types.Int64Value(456), data.NodeBalancerID)
+
+	var status []struct {
+		Up   types.Int64 `tfsdk:"up"`
+		Down types.Int64 `tfsdk:"down"`
+	}
+	require.False(t, data.NodeStatus.ElementsAs(context.Background(), &status, false).HasError())
+	require.Len(t, status, 1)
+	assert.Equal(t, int64(37), status[0].Up.ValueInt64())
+	assert.Equal(t, int64(13), status[0].Down.ValueInt64())
+
+	// Attributes added after version 0 get their defaults
+	assert.Equal(t, types.BoolValue(false), data.WaitForHealthy)
+	assert.Equal(t, types.Int64Value(1), data.MinHealthyNodes)
+	assert.True(t, data.Timeouts.IsNull())
+}
+
+func TestUpgradeResourceStateV0Empty(t *testing.T) {
+	data := upgradeTestState(t, 0, `{
+		"id": "123",
+		"nodebalancer_id": 456,
+		"node_status": {"down": "", "up": ""}
+	}`)
+
+	var status []struct {
+		Up   types.Int64 `tfsdk:"up"`
+		Down types.Int64 `tfsdk:"down"`
+	}
+	require.False(t, data.NodeStatus.ElementsAs(context.Background(), &status, false).HasError())
+	require.Len(t, status, 1)
+	assert.Equal(t, int64(0), status[0].Up.ValueInt64())
+	assert.Equal(t, int64(0), status[0].Down.ValueInt64())
+}
+
+func TestUpgradeResourceStateV1(t *testing.T) {
+	data := upgradeTestState(t, 1, `{
+		"id": "123",
+		"nodebalancer_id": 456,
+		"port": 443,
+		"protocol": "https",
+		"proxy_protocol": "none",
+		"check_passive": true,
+		"ssl_cert": "",
+		"ssl_key": "",
+		"ssl_not_after": "",
+		"ssl_issuer": "",
+		"ssl_sans": [],
+		"ssl_renew_before_days": 0,
+		"ssl_commonname": "",
+		"ssl_fingerprint": "",
+		"wait_for_healthy": true,
+		"min_healthy_nodes": 2,
+		"node_status": [{"up": 2, "down": 1}],
+		"timeouts": {"create": "20m", "update": null}
+	}`)
+
+	assert.Equal(t, types.StringValue("https"), data.Protocol)
+	assert.Equal(t, types.BoolValue(true), data.CheckPassive)
+
+	// Empty values written by the SDKv2 implementation are converted to nulls
+	assert.True(t, data.SSLCert.IsNull())
+	assert.True(t, data.SSLKey.IsNull())
+	assert.True(t, data.SSLNotAfter.IsNull())
+	assert.True(t, data.SSLIssuer.IsNull())
+	assert.True(t, data.SSLSANs.IsNull())
+	assert.True(t, data.SSLRenewBeforeDays.IsNull())
+	assert.Equal(t, types.StringValue(""), data.SSLFingerprint)
+
+	assert.Equal(t, types.BoolValue(true), data.WaitForHealthy)
+	assert.Equal(t, types.Int64Value(2), data.MinHealthyNodes)
+	assert.Len(t, data.NodeStatus.Elements(), 1)
+
+	create, diags := data.Timeouts.Create(context.Background(), defaultHealthyTimeout)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "20m0s", create.String())
+}
+
+func TestFlattenNodeBalancerConfig(t *testing.T) {
+	config := linodego.NodeBalancerConfig{
+		ID:             123,
+		NodeBalancerID: 456,
+		Port:           443,
+		Protocol:       linodego.ProtocolHTTPS,
+		ProxyProtocol:  linodego.ProxyProtocolNone,
+		Algorithm:      linodego.AlgorithmRoundRobin,
+		Stickiness:     linodego.StickinessTable,
+		Check:          linodego.CheckConnection,
+		CheckInterval:  31,
+		CheckTimeout:   30,
+		CheckAttempts:  3,
+		CheckPassive:   true,
+		CipherSuite:    linodego.CipherRecommended,
+		SSLCommonName:  "www.example.com",
+		SSLFingerprint: "AA:BB",
+		NodesStatus: &linodego.NodeBalancerNodeStatus{
+			Up:   1,
+			Down: 2,
+		},
+	}
+
+	data := ResourceModel{
+		Protocol:       types.StringValue("HTTPS"),
+		SSLNotAfter:    types.StringValue("2030-01-01T00:00:00Z"),
+		SSLIssuer:      types.StringValue("CN=ca.example.com"),
+		SSLFingerprint: types.StringValue("AA:BB"),
+		SSLSANs:        types.ListNull(types.StringType),
+	}
+
+	require.False(t, data.FlattenNodeBalancerConfig(&config, false).HasError())
+
+	assert.Equal(t, types.StringValue("123"), data.ID)
+	assert.Equal(t, types.Int64Value(456), data.NodeBalancerID)
+	assert.Equal(t, types.StringValue("HTTPS"), data.Protocol, "protocol is compared case-insensitively")
+	assert.Equal(t, types.Int64Value(31), data.CheckInterval)
+	assert.Equal(t, types.StringValue("2030-01-01T00:00:00Z"), data.SSLNotAfter)
+	assert.Len(t, data.NodeStatus.Elements(), 1)
+
+	// A replaced certificate invalidates the metadata parsed from ssl_cert
+	config.SSLFingerprint = "CC:DD"
+	require.False(t, data.FlattenNodeBalancerConfig(&config, false).HasError())
+
+	assert.True(t, data.SSLNotAfter.IsNull())
+	assert.True(t, data.SSLIssuer.IsNull())
+	assert.Equal(t, types.StringValue("CC:DD"), data.SSLFingerprint)
+}
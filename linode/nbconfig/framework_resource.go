@@ -0,0 +1,441 @@
+package nbconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const defaultHealthyTimeout = 10 * time.Minute
+
+var (
+	_ resource.ResourceWithUpgradeState   = &Resource{}
+	_ resource.ResourceWithModifyPlan     = &Resource{}
+	_ resource.ResourceWithValidateConfig = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_nodebalancer_config",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create linode_nodebalancer_config")
+
+	var plan ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultHealthyTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeBalancerID := helper.FrameworkSafeInt64ToInt(plan.NodeBalancerID.ValueInt64(), &resp.Diagnostics)
+	createOpts := plan.GetCreateOptions(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "client.CreateNodeBalancerConfig(...)", map[string]any{
+		"port":     createOpts.Port,
+		"protocol": createOpts.Protocol,
+	})
+
+	config, err := client.CreateNodeBalancerConfig(ctx, nodeBalancerID, createOpts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Create Config on NodeBalancer %d", nodeBalancerID),
+			err.Error(),
+		)
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "config_id", config.ID)
+
+	// Add resource to TF states earlier to prevent
+	// dangling resources (resources created but not managed by TF)
+	resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(strconv.Itoa(config.ID)))
+	resp.State.SetAttribute(ctx, path.Root("nodebalancer_id"), plan.NodeBalancerID)
+
+	resp.Diagnostics.Append(waitForHealthy(ctx, client, &plan, nodeBalancerID, config.ID, createTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "client.GetNodeBalancerConfig(...)")
+
+	id := config.ID
+
+	config, err = client.GetNodeBalancerConfig(ctx, nodeBalancerID, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get NodeBalancer Config %d", id),
+			err.Error(),
+		)
+		return
+	}
+
+	// IDs should always be overridden during creation (see #1085)
+	// TODO: Remove when Crossplane empty string ID issue is resolved
+	plan.ID = types.StringValue(strconv.Itoa(id))
+
+	resp.Diagnostics.Append(plan.FlattenNodeBalancerConfig(config, true)...)
+	resp.Diagnostics.Append(plan.resolveSSLMetadata(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read linode_nodebalancer_config")
+
+	var state ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	nodeBalancerID, id := getNodeBalancerIDAndConfigID(state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "client.GetNodeBalancerConfig(...)")
+
+	config, err := client.GetNodeBalancerConfig(ctx, nodeBalancerID, id)
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			resp.Diagnostics.AddWarning(
+				"NodeBalancer Config Not Found",
+				fmt.Sprintf(
+					"Removing NodeBalancer Config %d of NodeBalancer %d from state because it no longer exists",
+					id, nodeBalancerID,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get NodeBalancer Config %d", id),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.FlattenNodeBalancerConfig(config, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.setSSLExpiring(time.Now())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update linode_nodebalancer_config")
+
+	var plan, state ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultHealthyTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeBalancerID, id := getNodeBalancerIDAndConfigID(state, &resp.Diagnostics)
+	updateOpts := plan.GetUpdateOptions(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "client.UpdateNodeBalancerConfig(...)", map[string]any{
+		"port":     updateOpts.Port,
+		"protocol": updateOpts.Protocol,
+	})
+
+	if _, err := client.UpdateNodeBalancerConfig(ctx, nodeBalancerID, id, updateOpts); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Update NodeBalancer Config %d", id),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(waitForHealthy(ctx, client, &plan, nodeBalancerID, id, updateTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "client.GetNodeBalancerConfig(...)")
+
+	config, err := client.GetNodeBalancerConfig(ctx, nodeBalancerID, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get NodeBalancer Config %d", id),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.FlattenNodeBalancerConfig(config, true)...)
+	resp.Diagnostics.Append(plan.resolveSSLMetadata(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete linode_nodebalancer_config")
+
+	var state ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	nodeBalancerID, id := getNodeBalancerIDAndConfigID(state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "client.DeleteNodeBalancerConfig(...)")
+
+	if err := client.DeleteNodeBalancerConfig(ctx, nodeBalancerID, id); err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Delete NodeBalancer Config %d", id),
+			err.Error(),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	tflog.Debug(ctx, "Import linode_nodebalancer_config")
+
+	helper.ImportStateWithMultipleIDs(
+		ctx, req, resp,
+		[]helper.ImportableID{
+			{
+				Name:          "nodebalancer_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+			{
+				Name:          "id",
+				TypeConverter: helper.IDTypeConverterString,
+			},
+		},
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Defaults aren't applied to imported resources
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_healthy"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("min_healthy_nodes"), int64(1))...)
+}
+
+func (r *Resource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var cert, key types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ssl_cert"), &cert)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ssl_key"), &key)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSSL(cert, key)...)
+}
+
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ResourceModel
+	var state *ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.planSSL(ctx, state, time.Now())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *Resource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: upgradeResourceStateV0,
+		},
+		1: {
+			StateUpgrader: upgradeResourceStateV1,
+		},
+	}
+}
+
+func upgradeResourceStateV0(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	upgradeResourceState(ctx, req, resp, 0)
+}
+
+func upgradeResourceStateV1(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	upgradeResourceState(ctx, req, resp, 1)
+}
+
+// upgradeResourceState decodes a state written by the SDKv2 implementation of
+// this resource from its raw JSON, since its schema changed between versions
+// without a version bump.
+func upgradeResourceState(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+	version int64,
+) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError(
+			"Failed to Upgrade State",
+			"The prior state of this linode_nodebalancer_config is not stored as JSON.",
+		)
+		return
+	}
+
+	var prior resourceModelV1
+	if err := json.Unmarshal(req.RawState.JSON, &prior); err != nil {
+		resp.Diagnostics.AddError("Failed to Upgrade State", err.Error())
+		return
+	}
+
+	nodeStatus, err := prior.nodeStatus(version)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Upgrade State", err.Error())
+		return
+	}
+
+	data, diags := prior.upgrade(ctx, nodeStatus)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func getNodeBalancerIDAndConfigID(data ResourceModel, diags *diag.Diagnostics) (int, int) {
+	id := helper.FrameworkSafeStringToInt(data.ID.ValueString(), diags)
+	nodeBalancerID := helper.FrameworkSafeInt64ToInt(data.NodeBalancerID.ValueInt64(), diags)
+	return nodeBalancerID, id
+}
+
+func populateLogAttributes(ctx context.Context, data ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"nodebalancer_id": data.NodeBalancerID.ValueInt64(),
+		"config_id":       data.ID.ValueString(),
+	})
+}
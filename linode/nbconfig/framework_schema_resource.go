@@ -0,0 +1,268 @@
+package nbconfig
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+)
+
+var frameworkResourceSchema = schema.Schema{
+	Version: 2,
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the NodeBalancer config.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"nodebalancer_id": schema.Int64Attribute{
+			Description: "The ID of the NodeBalancer to access.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"protocol": schema.StringAttribute{
+			Description: "The protocol this port is configured to serve. If this is set to https you must " +
+				"include an ssl_cert and an ssl_key.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(string(linodego.ProtocolHTTP)),
+			Validators: []validator.String{
+				stringvalidator.OneOfCaseInsensitive("http", "https", "tcp"),
+			},
+		},
+		"proxy_protocol": schema.StringAttribute{
+			Description: "The version of ProxyProtocol to use for the underlying NodeBalancer. " +
+				"This requires protocol to be `tcp`. Valid values are `none`, `v1`, and `v2`.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(string(linodego.ProxyProtocolNone)),
+			Validators: []validator.String{
+				stringvalidator.OneOf("none", "v1", "v2"),
+			},
+		},
+		"port": schema.Int64Attribute{
+			Description: "The TCP port this Config is for. These values must be unique across configs on a " +
+				"single NodeBalancer (you can't have two configs for port 80, for example). While some ports imply " +
+				"some protocols, no enforcement is done and you may configure your NodeBalancer however is useful to " +
+				"you. For example, while port 443 is generally used for HTTPS, you do not need SSL configured to have " +
+				"a NodeBalancer listening on port 443.",
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(80),
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+		},
+		"check_interval": schema.Int64Attribute{
+			Description: "How often, in seconds, to check that backends are up and serving requests.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"check_timeout": schema.Int64Attribute{
+			Description: "How long, in seconds, to wait for a check attempt before considering it failed. (1-30)",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 30),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"check_attempts": schema.Int64Attribute{
+			Description: "How many times to attempt a check before considering a backend to be down. (1-30)",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 30),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"algorithm": schema.StringAttribute{
+			Description: "What algorithm this NodeBalancer should use for routing traffic to backends: roundrobin, " +
+				"leastconn, source",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("roundrobin", "leastconn", "source"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"stickiness": schema.StringAttribute{
+			Description: "Controls how session stickiness is handled on this port: 'none', 'table', 'http_cookie'",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("none", "table", "http_cookie"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"check": schema.StringAttribute{
+			Description: "The type of check to perform against backends to ensure they are serving requests. " +
+				"This is used to determine if backends are up or down. If none no check is performed. connection " +
+				"requires only a connection to the backend to succeed. http and http_body rely on the backend " +
+				"serving HTTP, and that the response returned matches what is expected.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("none", "connection", "http", "http_body"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"check_path": schema.StringAttribute{
+			Description: "The URL path to check on each backend. If the backend does not respond to this request " +
+				"it is considered to be down.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"check_body": schema.StringAttribute{
+			Description: "This value must be present in the response body of the check in order for it to pass. " +
+				"If this value is not present in the response body of a check request, the backend is considered " +
+				"to be down",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"check_passive": schema.BoolAttribute{
+			Description: "If true, any response from this backend with a 5xx status code will be enough for it to " +
+				"be considered unhealthy and taken out of rotation.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"cipher_suite": schema.StringAttribute{
+			Description: "What ciphers to use for SSL connections served by this NodeBalancer. `legacy` is " +
+				"considered insecure and should only be used if necessary.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("recommended", "legacy"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ssl_commonname": schema.StringAttribute{
+			Description: "The read-only common name automatically derived from the SSL certificate assigned to " +
+				"this NodeBalancerConfig. Please refer to this field to verify that the appropriate certificate is " +
+				"assigned to your NodeBalancerConfig.",
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ssl_fingerprint": schema.StringAttribute{
+			Description: "The read-only fingerprint automatically derived from the SSL certificate assigned to " +
+				"this NodeBalancerConfig. Please refer to this field to verify that the appropriate certificate is " +
+				"assigned to your NodeBalancerConfig.",
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ssl_not_after": schema.StringAttribute{
+			Description: "When the certificate assigned to this NodeBalancerConfig expires, parsed from ssl_cert.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ssl_issuer": schema.StringAttribute{
+			Description: "The issuer of the certificate assigned to this NodeBalancerConfig, parsed from ssl_cert.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ssl_sans": schema.ListAttribute{
+			Description: "The DNS names and IP addresses the certificate assigned to this NodeBalancerConfig is " +
+				"valid for, parsed from ssl_cert.",
+			Computed:    true,
+			ElementType: types.StringType,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ssl_renew_before_days": schema.Int64Attribute{
+			Description: "If set, ssl_expiring is true and a warning is shown at plan time once the certificate " +
+				"expires within this number of days.",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"ssl_expiring": schema.BoolAttribute{
+			Description: "Whether the certificate assigned to this NodeBalancerConfig expires within " +
+				"ssl_renew_before_days.",
+			Computed: true,
+		},
+		"ssl_cert": schema.StringAttribute{
+			Description: "The certificate this port is serving. This is not returned by the API, so the configured " +
+				"value is kept in state. Please use the ssl_commonname and ssl_fingerprint to identify the certificate.",
+			Optional:  true,
+			Sensitive: true,
+		},
+		"ssl_key": schema.StringAttribute{
+			Description: "The private key corresponding to this port's certificate. This is not returned by the API, " +
+				"so the configured value is kept in state. Please use the ssl_commonname and ssl_fingerprint to " +
+				"identify the certificate.",
+			Optional:  true,
+			Sensitive: true,
+		},
+		"wait_for_healthy": schema.BoolAttribute{
+			Description: "If true, creating or updating this config waits until at least min_healthy_nodes of its " +
+				"nodes are reported as UP, bounded by the create and update timeouts. The wait is skipped while the " +
+				"config has no nodes.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"min_healthy_nodes": schema.Int64Attribute{
+			Description: "The number of nodes that must be UP when wait_for_healthy is set.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(1),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"node_status": schema.ListAttribute{
+			Description: "A structure containing information about the health of the backends for this port. This " +
+				"information is updated periodically as checks are performed against backends.",
+			Computed:    true,
+			ElementType: statusObjectType,
+		},
+	},
+}
@@ -0,0 +1,217 @@
+package nbconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// parseCertificateChain parses a PEM encoded certificate chain, leaf first, and
+// verifies that each certificate is signed by the one following it.
+func parseCertificateChain(data string) ([]*x509.Certificate, error) {
+	var chain []*x509.Certificate
+
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block of type %s in certificate chain", block.Type)
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate %d of the chain: %w", len(chain), err)
+		}
+
+		chain = append(chain, cert)
+	}
+
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+
+	for i := 0; i < len(chain)-1; i++ {
+		if err := chain[i].CheckSignatureFrom(chain[i+1]); err != nil {
+			return nil, fmt.Errorf(
+				"certificate %d (%s) is not signed by the next certificate in the chain (%s): %w",
+				i, chain[i].Subject, chain[i+1].Subject, err,
+			)
+		}
+	}
+
+	return chain, nil
+}
+
+// validateKeyPair checks that the private key matches the leaf certificate.
+func validateKeyPair(cert, key string) error {
+	if _, err := tls.X509KeyPair([]byte(cert), []byte(key)); err != nil {
+		return fmt.Errorf("ssl_key does not match ssl_cert: %w", err)
+	}
+
+	return nil
+}
+
+// certificateSANs returns the DNS names and IP addresses the certificate is valid for.
+func certificateSANs(cert *x509.Certificate) []string {
+	sans := make([]string, 0, len(cert.DNSNames)+len(cert.IPAddresses))
+
+	sans = append(sans, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+
+	return sans
+}
+
+// expiresWithin returns whether a certificate valid until notAfter expires within
+// the given number of days from now.
+func expiresWithin(notAfter time.Time, days int, now time.Time) bool {
+	return !now.AddDate(0, 0, days).Before(notAfter)
+}
+
+// validateSSL checks that the certificate chain is valid and that the private
+// key matches its leaf certificate. Values that aren't known yet are skipped.
+func validateSSL(cert, key types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if cert.IsUnknown() || cert.ValueString() == "" {
+		return nil
+	}
+
+	if _, err := parseCertificateChain(cert.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("ssl_cert"), "Invalid SSL Certificate", err.Error())
+		return diags
+	}
+
+	if key.IsUnknown() || key.ValueString() == "" {
+		return nil
+	}
+
+	if err := validateKeyPair(cert.ValueString(), key.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("ssl_key"), "Invalid SSL Key", err.Error())
+	}
+
+	return diags
+}
+
+// planSSL computes the certificate metadata of the planned config and warns when
+// its certificate expires within ssl_renew_before_days. state is nil when the
+// config is being created.
+func (data *ResourceModel) planSSL(
+	ctx context.Context,
+	state *ResourceModel,
+	now time.Time,
+) diag.Diagnostics {
+	if data.SSLCert.IsUnknown() || data.SSLKey.IsUnknown() {
+		if state == nil || !data.SSLCert.Equal(state.SSLCert) {
+			data.unknownSSLMetadata()
+			data.SSLCommonName = types.StringUnknown()
+			data.SSLFingerprint = types.StringUnknown()
+		}
+		data.setSSLExpiring(now)
+		return nil
+	}
+
+	if diags := validateSSL(data.SSLCert, data.SSLKey); diags.HasError() {
+		return diags
+	}
+
+	if state == nil || !data.SSLCert.Equal(state.SSLCert) {
+		data.SSLCommonName = types.StringUnknown()
+		data.SSLFingerprint = types.StringUnknown()
+
+		if diags := data.setSSLMetadata(ctx); diags.HasError() {
+			return diags
+		}
+	}
+
+	data.setSSLExpiring(now)
+	if !data.SSLExpiring.ValueBool() {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	// Replacing the config wouldn't renew the certificate, so the expiry is only
+	// surfaced until a renewed certificate is supplied.
+	diags.AddAttributeWarning(
+		path.Root("ssl_cert"),
+		"SSL Certificate Expiring",
+		fmt.Sprintf(
+			"The certificate of this NodeBalancer Config expires at %s, within %d days. "+
+				"Please supply a renewed certificate in ssl_cert and ssl_key.",
+			data.SSLNotAfter.ValueString(), data.SSLRenewBeforeDays.ValueInt64(),
+		),
+	)
+
+	return diags
+}
+
+// waitForHealthy waits for min_healthy_nodes nodes of the config to become healthy
+// when wait_for_healthy is set. Nodes are commonly managed by linode_nodebalancer_node
+// resources that depend on this config, so a config without nodes is not waited on.
+func waitForHealthy(
+	ctx context.Context,
+	client *linodego.Client,
+	data *ResourceModel,
+	nodeBalancerID, configID int,
+	timeout time.Duration,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !data.WaitForHealthy.ValueBool() {
+		return nil
+	}
+
+	tflog.Trace(ctx, "client.ListNodeBalancerNodes(...)")
+
+	nodes, err := client.ListNodeBalancerNodes(ctx, nodeBalancerID, configID, nil)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to List Nodes of NodeBalancer Config %d", configID), err.Error())
+		return diags
+	}
+
+	if len(nodes) == 0 {
+		diags.AddWarning(
+			"NodeBalancer config has no nodes",
+			fmt.Sprintf("Skipped waiting for NodeBalancer config %d to become healthy because it "+
+				"has no nodes; use wait_for_healthy on linode_nodebalancer_node instead.", configID),
+		)
+		return diags
+	}
+
+	minUp := helper.FrameworkSafeInt64ToInt(data.MinHealthyNodes.ValueInt64(), &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Debug(ctx, "Waiting for NodeBalancer config to become healthy", map[string]any{
+		"min_healthy_nodes": minUp,
+	})
+
+	if _, err := helper.WaitForNodeBalancerConfigHealthy(
+		ctx, *client, nodeBalancerID, configID, minUp, timeout,
+	); err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to Wait for NodeBalancer Config %d to Become Healthy", configID),
+			err.Error(),
+		)
+	}
+
+	return diags
+}
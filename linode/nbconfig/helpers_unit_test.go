@@ -0,0 +1,220 @@
+//go:build unit
+
+package nbconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCertificate struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  string
+}
+
+func (c testCertificate) keyPEM(t *testing.T) string {
+	der, err := x509.MarshalPKCS8PrivateKey(c.key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func newTestCertificate(t *testing.T, cn string, notAfter time.Time, parent *testCertificate) testCertificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		DNSNames:              []string{cn},
+		IPAddresses:           []net.IP{net.ParseIP("192.0.2.1")},
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+
+	issuer, signer := template, key
+	if parent != nil {
+		issuer, signer = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, &key.PublicKey, signer)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return testCertificate{
+		cert: cert,
+		key:  key,
+		pem:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+}
+
+func TestParseCertificateChain(t *testing.T) {
+	ca := newTestCertificate(t, "ca.example.com", time.Now().AddDate(1, 0, 0), nil)
+	leaf := newTestCertificate(t, "www.example.com", time.Now().AddDate(0, 3, 0), &ca)
+	other := newTestCertificate(t, "other.example.com", time.Now().AddDate(1, 0, 0), nil)
+
+	chain, err := parseCertificateChain(leaf.pem + ca.pem)
+	assert.NoError(t, err)
+	assert.Len(t, chain, 2)
+	assert.Equal(t, "www.example.com", chain[0].Subject.CommonName)
+
+	_, err = parseCertificateChain(ca.pem + leaf.pem)
+	assert.ErrorContains(t, err, "certificate 0 (CN=ca.example.com) is not signed by the next certificate")
+
+	_, err = parseCertificateChain(leaf.pem + other.pem)
+	assert.ErrorContains(t, err, "is not signed by the next certificate in the chain (CN=other.example.com)")
+
+	_, err = parseCertificateChain(leaf.pem + leaf.keyPEM(t))
+	assert.ErrorContains(t, err, "unexpected PEM block of type PRIVATE KEY")
+
+	_, err = parseCertificateChain("not a certificate")
+	assert.ErrorContains(t, err, "no PEM encoded certificate found")
+}
+
+func TestValidateKeyPair(t *testing.T) {
+	leaf := newTestCertificate(t, "www.example.com", time.Now().AddDate(0, 3, 0), nil)
+	other := newTestCertificate(t, "other.example.com", time.Now().AddDate(0, 3, 0), nil)
+
+	assert.NoError(t, validateKeyPair(leaf.pem, leaf.keyPEM(t)))
+	assert.ErrorContains(t, validateKeyPair(leaf.pem, other.keyPEM(t)), "ssl_key does not match ssl_cert")
+}
+
+func TestCertificateMetadata(t *testing.T) {
+	now := time.Now()
+	leaf := newTestCertificate(t, "www.example.com", now.AddDate(0, 0, 10), nil)
+
+	assert.Equal(t, []string{"www.example.com", "192.0.2.1"}, certificateSANs(leaf.cert))
+
+	assert.True(t, expiresWithin(leaf.cert.NotAfter, 10, now))
+	assert.True(t, expiresWithin(leaf.cert.NotAfter, 30, now))
+	assert.False(t, expiresWithin(leaf.cert.NotAfter, 9, now))
+}
+
+func TestPlanSSL(t *testing.T) {
+	ca := newTestCertificate(t, "ca.example.com", time.Now().AddDate(1, 0, 0), nil)
+	leaf := newTestCertificate(t, "www.example.com", time.Now().AddDate(0, 0, 10), &ca)
+	other := newTestCertificate(t, "other.example.com", time.Now().AddDate(1, 0, 0), nil)
+
+	ctx := context.Background()
+
+	model := func(cert, key string, renewDays int64) ResourceModel {
+		data := ResourceModel{
+			SSLCert:            types.StringValue(cert),
+			SSLKey:             types.StringValue(key),
+			SSLRenewBeforeDays: types.Int64Null(),
+			SSLCommonName:      types.StringUnknown(),
+			SSLFingerprint:     types.StringUnknown(),
+		}
+		data.unknownSSLMetadata()
+
+		if renewDays > 0 {
+			data.SSLRenewBeforeDays = types.Int64Value(renewDays)
+		}
+
+		return data
+	}
+
+	t.Run("metadata", func(t *testing.T) {
+		plan := model(leaf.pem+ca.pem, leaf.keyPEM(t), 0)
+
+		diags := plan.planSSL(ctx, nil, time.Now())
+		require.False(t, diags.HasError(), diags)
+		assert.Empty(t, diags)
+		assert.Equal(t, types.BoolValue(false), plan.SSLExpiring)
+
+		assert.Equal(t, leaf.cert.NotAfter.Format(time.RFC3339), plan.SSLNotAfter.ValueString())
+		assert.Equal(t, "CN=ca.example.com", plan.SSLIssuer.ValueString())
+
+		var sans []string
+		require.False(t, plan.SSLSANs.ElementsAs(ctx, &sans, false).HasError())
+		assert.Equal(t, []string{"www.example.com", "192.0.2.1"}, sans)
+	})
+
+	t.Run("no certificate", func(t *testing.T) {
+		plan := model("", "", 0)
+		plan.SSLCert = types.StringNull()
+		plan.SSLKey = types.StringNull()
+
+		diags := plan.planSSL(ctx, nil, time.Now())
+		require.False(t, diags.HasError(), diags)
+
+		assert.True(t, plan.SSLNotAfter.IsNull())
+		assert.True(t, plan.SSLIssuer.IsNull())
+		assert.True(t, plan.SSLSANs.IsNull())
+		assert.True(t, plan.SSLExpiring.IsNull())
+	})
+
+	t.Run("unknown certificate", func(t *testing.T) {
+		plan := model("", "", 0)
+		plan.SSLCert = types.StringUnknown()
+
+		diags := plan.planSSL(ctx, nil, time.Now())
+		require.False(t, diags.HasError(), diags)
+
+		assert.True(t, plan.SSLNotAfter.IsUnknown())
+		assert.True(t, plan.SSLSANs.IsUnknown())
+	})
+
+	t.Run("mismatched key", func(t *testing.T) {
+		plan := model(leaf.pem, other.keyPEM(t), 0)
+
+		diags := plan.planSSL(ctx, nil, time.Now())
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Detail(), "ssl_key does not match ssl_cert")
+	})
+
+	t.Run("broken chain", func(t *testing.T) {
+		plan := model(leaf.pem+other.pem, leaf.keyPEM(t), 0)
+
+		diags := plan.planSSL(ctx, nil, time.Now())
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Invalid SSL Certificate", diags[0].Summary())
+	})
+
+	t.Run("renewal", func(t *testing.T) {
+		state := model(leaf.pem, leaf.keyPEM(t), 5)
+		diags := state.planSSL(ctx, nil, time.Now())
+		require.False(t, diags.HasError(), diags)
+		state.SSLCommonName = types.StringValue("www.example.com")
+		state.SSLFingerprint = types.StringValue("AA:BB")
+
+		plan := state
+		diags = plan.planSSL(ctx, &state, time.Now())
+		require.False(t, diags.HasError(), diags)
+		assert.Empty(t, diags)
+		assert.Equal(t, state, plan)
+		assert.False(t, plan.SSLExpiring.ValueBool())
+
+		// The expiring certificate is only surfaced, the config isn't replaced
+		plan = state
+		plan.SSLRenewBeforeDays = types.Int64Value(30)
+		diags = plan.planSSL(ctx, &state, time.Now())
+		require.False(t, diags.HasError(), diags)
+		require.Len(t, diags, 1)
+		assert.Equal(t, "SSL Certificate Expiring", diags[0].Summary())
+		assert.True(t, plan.SSLExpiring.ValueBool())
+		assert.Equal(t, state.SSLNotAfter, plan.SSLNotAfter)
+		assert.Equal(t, state.SSLFingerprint, plan.SSLFingerprint)
+	})
+}
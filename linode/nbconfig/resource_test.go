@@ -6,7 +6,7 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"reflect"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -16,7 +16,6 @@ import (
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
-	"github.com/linode/terraform-provider-linode/v2/linode/nbconfig"
 	"github.com/linode/terraform-provider-linode/v2/linode/nbconfig/tmpl"
 )
 
@@ -77,6 +76,63 @@ func TestAccResourceNodeBalancerConfig_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceNodeBalancerConfig_sslRenew(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_nodebalancer_config.foofig"
+	nodebalancerName := acctest.RandomWithPrefix("tf_test")
+	config := tmpl.SSLRenew(t, nodebalancerName, testRegion, tmpl.TestCertifcate, tmpl.TestPrivateKey)
+
+	resource.Test(t, resource.TestCase{
+		PreventPostDestroyRefresh: true,
+		PreCheck:                  func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories:  acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:              checkNodeBalancerConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkNodeBalancerConfigExists,
+					resource.TestCheckResourceAttr(resName, "ssl_renew_before_days", "30"),
+					resource.TestCheckResourceAttr(resName, "ssl_not_after", "2021-10-05T18:40:52Z"),
+					// The test certificate has already expired
+					resource.TestCheckResourceAttr(resName, "ssl_expiring", "true"),
+				),
+			},
+			{
+				// An expiring certificate doesn't plan a replacement
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNodeBalancerConfig_waitForHealthy(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_nodebalancer_config.foofig"
+	nodebalancerName := acctest.RandomWithPrefix("tf_test")
+	resource.Test(t, resource.TestCase{
+		PreventPostDestroyRefresh: true,
+		PreCheck:                  func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories:  acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:              checkNodeBalancerConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				// A config without nodes is not waited on
+				Config: tmpl.WaitForHealthy(t, nodebalancerName, testRegion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkNodeBalancerConfigExists,
+					resource.TestCheckResourceAttr(resName, "wait_for_healthy", "true"),
+					resource.TestCheckResourceAttr(resName, "min_healthy_nodes", "2"),
+					resource.TestCheckResourceAttr(resName, "node_status.0.up", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNodeBalancerConfig_ssl(t *testing.T) {
 	t.Parallel()
 
@@ -96,13 +152,16 @@ func TestAccResourceNodeBalancerConfig_ssl(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "protocol", string(linodego.ProtocolHTTPS)),
 					resource.TestCheckResourceAttrSet(resName, "ssl_cert"),
 					resource.TestCheckResourceAttrSet(resName, "ssl_key"),
+					resource.TestCheckResourceAttr(resName, "ssl_not_after", "2021-10-05T18:40:52Z"),
+					resource.TestMatchResourceAttr(resName, "ssl_issuer", regexp.MustCompile("CN=linode-obj-bucket-cert-test.xyz")),
+					resource.TestCheckResourceAttr(resName, "ssl_sans.#", "0"),
 				),
 			},
 			{
 				ResourceName:            resName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ssl_cert", "ssl_key"},
+				ImportStateVerifyIgnore: []string{"ssl_cert", "ssl_key", "ssl_not_after", "ssl_issuer", "ssl_sans"},
 				ImportStateIdFunc:       resourceImportStateID,
 			},
 		},
@@ -179,64 +238,6 @@ func TestAccResourceNodeBalancerConfig_proxyProtocol(t *testing.T) {
 	})
 }
 
-func TestLinodeNodeBalancerConfig_UpgradeV0(t *testing.T) {
-	t.Parallel()
-
-	oldState := map[string]interface{}{
-		"node_status": map[string]interface{}{
-			"down": "13",
-			"up":   "37",
-		},
-	}
-
-	desiredState := map[string]interface{}{
-		"node_status": []map[string]interface{}{
-			{
-				"down": 13,
-				"up":   37,
-			},
-		},
-	}
-
-	newState, err := nbconfig.ResourceNodeBalancerConfigV0Upgrade(context.Background(), oldState, nil)
-	if err != nil {
-		t.Fatalf("error migrating state: %v", err)
-	}
-
-	if !reflect.DeepEqual(desiredState, newState) {
-		t.Fatalf("expected %v, got %v", desiredState, newState)
-	}
-}
-
-func TestLinodeNodeBalancerConfig_UpgradeV0Empty(t *testing.T) {
-	t.Parallel()
-
-	oldState := map[string]interface{}{
-		"node_status": map[string]interface{}{
-			"down": "",
-			"up":   "",
-		},
-	}
-
-	desiredState := map[string]interface{}{
-		"node_status": []map[string]interface{}{
-			{
-				"down": 0,
-				"up":   0,
-			},
-		},
-	}
-
-	newState, err := nbconfig.ResourceNodeBalancerConfigV0Upgrade(context.Background(), oldState, nil)
-	if err != nil {
-		t.Fatalf("error migrating state: %v", err)
-	}
-
-	if !reflect.DeepEqual(desiredState, newState) {
-		t.Fatalf("expected %v, got %v", desiredState, newState)
-	}
-}
-
 func checkNodeBalancerConfigExists(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
 
@@ -137,6 +137,18 @@ func SSL(t *testing.T, nodebalancerName, region, cert, privKey string) string {
 		})
 }
 
+func SSLRenew(t *testing.T, nodebalancerName, region, cert, privKey string) string {
+	return acceptance.ExecuteTemplate(t,
+		"nodebalancer_config_ssl_renew", TemplateData{
+			SSLCert: cert,
+			SSLKey:  privKey,
+			NodeBalancer: nodebalancer.TemplateData{
+				Label:  nodebalancerName,
+				Region: region,
+			},
+		})
+}
+
 func ProxyProtocol(t *testing.T, nodebalancerName, region string) string {
 	return acceptance.ExecuteTemplate(t,
 		"nodebalancer_config_proxy_protocol", TemplateData{
@@ -147,6 +159,16 @@ func ProxyProtocol(t *testing.T, nodebalancerName, region string) string {
 		})
 }
 
+func WaitForHealthy(t *testing.T, nodebalancerName, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"nodebalancer_config_wait_for_healthy", TemplateData{
+			NodeBalancer: nodebalancer.TemplateData{
+				Label:  nodebalancerName,
+				Region: region,
+			},
+		})
+}
+
 func DataBasic(t *testing.T, nodebalancerName, region string) string {
 	return acceptance.ExecuteTemplate(t,
 		"nodebalancer_config_data_basic", TemplateData{
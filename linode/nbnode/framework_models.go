@@ -1,8 +1,14 @@
 package nbnode
 
 import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
 type DataSourceModel struct {
@@ -26,3 +32,126 @@ func (data *DataSourceModel) ParseNodeBalancerNode(nbnode *linodego.NodeBalancer
 	data.Address = types.StringValue(nbnode.Address)
 	data.Status = types.StringValue(nbnode.Status)
 }
+
+// ResourceModel describes the Terraform resource data model to match the
+// resource schema.
+type ResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	NodeBalancerID types.Int64    `tfsdk:"nodebalancer_id"`
+	ConfigID       types.Int64    `tfsdk:"config_id"`
+	Label          types.String   `tfsdk:"label"`
+	Weight         types.Int64    `tfsdk:"weight"`
+	Mode           types.String   `tfsdk:"mode"`
+	Address        types.String   `tfsdk:"address"`
+	SubnetID       types.Int64    `tfsdk:"subnet_id"`
+	VPCID          types.Int64    `tfsdk:"vpc_id"`
+	WaitForHealthy types.Bool     `tfsdk:"wait_for_healthy"`
+	Status         types.String   `tfsdk:"status"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (data *ResourceModel) FlattenNodeBalancerNode(node *linodego.NodeBalancerNode, preserveKnown bool) {
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(node.ID), preserveKnown)
+	data.NodeBalancerID = helper.KeepOrUpdateInt64(data.NodeBalancerID, int64(node.NodeBalancerID), preserveKnown)
+	data.ConfigID = helper.KeepOrUpdateInt64(data.ConfigID, int64(node.ConfigID), preserveKnown)
+	data.Label = helper.KeepOrUpdateString(data.Label, node.Label, preserveKnown)
+	data.Weight = helper.KeepOrUpdateInt64(data.Weight, int64(node.Weight), preserveKnown)
+	data.Mode = helper.KeepOrUpdateString(data.Mode, string(node.Mode), preserveKnown)
+	data.Address = helper.KeepOrUpdateString(data.Address, node.Address, preserveKnown)
+	data.Status = helper.KeepOrUpdateString(data.Status, node.Status, preserveKnown)
+}
+
+// FlattenSubnetID stores the VPC subnet of the node's address. The VPC of the
+// subnet isn't returned, so vpc_id is only cleared along with the subnet.
+func (data *ResourceModel) FlattenSubnetID(subnetID int) {
+	if subnetID == 0 {
+		data.SubnetID = types.Int64Null()
+		data.VPCID = types.Int64Null()
+		return
+	}
+
+	data.SubnetID = types.Int64Value(int64(subnetID))
+}
+
+func (data *ResourceModel) GetCreateOptions(diags *diag.Diagnostics) nodeCreateOptions {
+	return nodeCreateOptions{
+		NodeBalancerNodeCreateOptions: linodego.NodeBalancerNodeCreateOptions{
+			Address: data.Address.ValueString(),
+			Label:   data.Label.ValueString(),
+			Mode:    linodego.NodeMode(data.Mode.ValueString()),
+			Weight:  helper.FrameworkSafeInt64ToInt(data.Weight.ValueInt64(), diags),
+		},
+		SubnetID: helper.FrameworkSafeInt64ToInt(data.SubnetID.ValueInt64(), diags),
+	}
+}
+
+func (data *ResourceModel) GetUpdateOptions(diags *diag.Diagnostics) nodeUpdateOptions {
+	return nodeUpdateOptions{
+		NodeBalancerNodeUpdateOptions: linodego.NodeBalancerNodeUpdateOptions{
+			Address: data.Address.ValueString(),
+			Label:   data.Label.ValueString(),
+			Mode:    linodego.NodeMode(data.Mode.ValueString()),
+			Weight:  helper.FrameworkSafeInt64ToInt(data.Weight.ValueInt64(), diags),
+		},
+		SubnetID: helper.FrameworkSafeInt64ToInt(data.SubnetID.ValueInt64(), diags),
+	}
+}
+
+// resourceModelV0 describes the state of linode_nodebalancer_node written by
+// the SDKv2 implementation of the resource.
+type resourceModelV0 struct {
+	ID             string      `json:"id"`
+	NodeBalancerID int64       `json:"nodebalancer_id"`
+	ConfigID       int64       `json:"config_id"`
+	Label          string      `json:"label"`
+	Weight         int64       `json:"weight"`
+	Mode           string      `json:"mode"`
+	Address        string      `json:"address"`
+	WaitForHealthy *bool       `json:"wait_for_healthy"`
+	Status         string      `json:"status"`
+	Timeouts       *timeoutsV0 `json:"timeouts"`
+}
+
+type timeoutsV0 struct {
+	Create *string `json:"create"`
+	Update *string `json:"update"`
+}
+
+// upgrade converts the prior state into the current resource model.
+func (data *resourceModelV0) upgrade() ResourceModel {
+	result := ResourceModel{
+		ID:             types.StringValue(data.ID),
+		NodeBalancerID: types.Int64Value(data.NodeBalancerID),
+		ConfigID:       types.Int64Value(data.ConfigID),
+		Label:          types.StringValue(data.Label),
+		Weight:         types.Int64Value(data.Weight),
+		Mode:           types.StringValue(data.Mode),
+		Address:        types.StringValue(data.Address),
+		SubnetID:       types.Int64Null(),
+		VPCID:          types.Int64Null(),
+		WaitForHealthy: types.BoolValue(false),
+		Status:         types.StringValue(data.Status),
+	}
+
+	if data.WaitForHealthy != nil {
+		result.WaitForHealthy = types.BoolValue(*data.WaitForHealthy)
+	}
+
+	attrTypes := map[string]attr.Type{
+		"create": types.StringType,
+		"update": types.StringType,
+	}
+
+	if data.Timeouts == nil {
+		result.Timeouts = timeouts.Value{Object: types.ObjectNull(attrTypes)}
+	} else {
+		result.Timeouts = timeouts.Value{
+			Object: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"create": types.StringPointerValue(data.Timeouts.Create),
+				"update": types.StringPointerValue(data.Timeouts.Update),
+			}),
+		}
+	}
+
+	return result
+}
@@ -3,11 +3,17 @@
 package nbnode
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParseNodeBalancerNode(t *testing.T) {
@@ -35,3 +41,75 @@ func TestParseNodeBalancerNode(t *testing.T) {
 	assert.Equal(t, types.StringValue("192.168.210.120:80"), data.Address)
 	assert.Equal(t, types.StringValue("UP"), data.Status)
 }
+
+func TestFlattenNodeBalancerNode(t *testing.T) {
+	node := &linodego.NodeBalancerNode{
+		ID:             54321,
+		Address:        "192.168.210.120:80",
+		Label:          "node54321",
+		Status:         "UP",
+		Weight:         50,
+		Mode:           "accept",
+		ConfigID:       4567,
+		NodeBalancerID: 12345,
+	}
+
+	data := &ResourceModel{
+		ID:     types.StringUnknown(),
+		Weight: types.Int64Unknown(),
+		Mode:   types.StringValue("drain"),
+		Status: types.StringUnknown(),
+	}
+
+	data.FlattenNodeBalancerNode(node, true)
+
+	assert.Equal(t, types.StringValue("54321"), data.ID)
+	assert.Equal(t, types.Int64Value(50), data.Weight)
+	assert.Equal(t, types.StringValue("drain"), data.Mode)
+	assert.Equal(t, types.StringValue("UP"), data.Status)
+
+	data.FlattenNodeBalancerNode(node, false)
+
+	assert.Equal(t, types.StringValue("accept"), data.Mode)
+}
+
+func TestUpgradeResourceStateV0(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	req := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(`{
+			"id": "54321",
+			"nodebalancer_id": 12345,
+			"config_id": 4567,
+			"label": "node54321",
+			"weight": 50,
+			"mode": "accept",
+			"address": "192.168.210.120:80",
+			"status": "UP",
+			"timeouts": null
+		}`)},
+	}
+	resp := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	upgradeResourceStateV0(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var data ResourceModel
+	require.False(t, resp.State.Get(ctx, &data).HasError())
+
+	assert.Equal(t, types.StringValue("54321"), data.ID)
+	assert.Equal(t, types.Int64Value(12345), data.NodeBalancerID)
+	assert.Equal(t, types.Int64Value(4567), data.ConfigID)
+	assert.Equal(t, types.StringValue("192.168.210.120:80"), data.Address)
+	assert.Equal(t, types.BoolValue(false), data.WaitForHealthy)
+	assert.True(t, data.Timeouts.IsNull())
+}
@@ -0,0 +1,411 @@
+package nbnode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const defaultHealthyTimeout = 10 * time.Minute
+
+var (
+	_ resource.ResourceWithUpgradeState = &Resource{}
+	_ resource.ResourceWithModifyPlan   = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_nodebalancer_node",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create linode_nodebalancer_node")
+
+	var plan ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultHealthyTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeBalancerID := helper.FrameworkSafeInt64ToInt(plan.NodeBalancerID.ValueInt64(), &resp.Diagnostics)
+	configID := helper.FrameworkSafeInt64ToInt(plan.ConfigID.ValueInt64(), &resp.Diagnostics)
+	createOpts := plan.GetCreateOptions(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "client.CreateNodeBalancerNode(...)", map[string]any{
+		"options": createOpts,
+	})
+
+	node, err := createNodeBalancerNode(ctx, client, nodeBalancerID, configID, createOpts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Create Node on NodeBalancer %d Config %d", nodeBalancerID, configID),
+			err.Error(),
+		)
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "node_id", node.ID)
+
+	// Add resource to TF states earlier to prevent
+	// dangling resources (resources created but not managed by TF)
+	resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(strconv.Itoa(node.ID)))
+	resp.State.SetAttribute(ctx, path.Root("nodebalancer_id"), plan.NodeBalancerID)
+	resp.State.SetAttribute(ctx, path.Root("config_id"), plan.ConfigID)
+
+	if plan.WaitForHealthy.ValueBool() {
+		node = waitForHealthy(ctx, client, nodeBalancerID, configID, node.ID, createTimeout, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// IDs should always be overridden during creation (see #1085)
+	// TODO: Remove when Crossplane empty string ID issue is resolved
+	plan.ID = types.StringValue(strconv.Itoa(node.ID))
+
+	plan.FlattenNodeBalancerNode(node, true)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read linode_nodebalancer_node")
+
+	var state ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	nodeBalancerID, configID, id := getNodeIDs(state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "getNodeBalancerNode(...)")
+
+	node, err := getNodeBalancerNode(ctx, client, nodeBalancerID, configID, id)
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			resp.Diagnostics.AddWarning(
+				"NodeBalancer Node Not Found",
+				fmt.Sprintf(
+					"Removing NodeBalancer Node %d of NodeBalancer %d Config %d from state because it "+
+						"no longer exists",
+					id, nodeBalancerID, configID,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get NodeBalancer Node %d", id),
+			err.Error(),
+		)
+		return
+	}
+
+	state.FlattenNodeBalancerNode(&node.NodeBalancerNode, false)
+	state.FlattenSubnetID(node.SubnetID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update linode_nodebalancer_node")
+
+	var plan, state ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultHealthyTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeBalancerID, configID, id := getNodeIDs(state, &resp.Diagnostics)
+	updateOpts := plan.GetUpdateOptions(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "client.UpdateNodeBalancerNode(...)", map[string]any{
+		"options": updateOpts,
+	})
+
+	node, err := updateNodeBalancerNode(ctx, client, nodeBalancerID, configID, id, updateOpts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Update NodeBalancer Node %d", id),
+			err.Error(),
+		)
+		return
+	}
+
+	if plan.WaitForHealthy.ValueBool() {
+		node = waitForHealthy(ctx, client, nodeBalancerID, configID, id, updateTimeout, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.FlattenNodeBalancerNode(node, true)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete linode_nodebalancer_node")
+
+	var state ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	nodeBalancerID, configID, id := getNodeIDs(state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "client.DeleteNodeBalancerNode(...)")
+
+	if err := client.DeleteNodeBalancerNode(ctx, nodeBalancerID, configID, id); err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Delete NodeBalancer Node %d", id),
+			err.Error(),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	tflog.Debug(ctx, "Import linode_nodebalancer_node")
+
+	helper.ImportStateWithMultipleIDs(
+		ctx, req, resp,
+		[]helper.ImportableID{
+			{
+				Name:          "nodebalancer_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+			{
+				Name:          "config_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+			{
+				Name:          "id",
+				TypeConverter: helper.IDTypeConverterString,
+			},
+		},
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Defaults aren't applied to imported resources
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_healthy"), false)...)
+}
+
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// The provider isn't configured yet when validating a plan without credentials
+	if req.Plan.Raw.IsNull() || r.Meta == nil {
+		return
+	}
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SubnetID.IsNull() || plan.SubnetID.IsUnknown() || plan.VPCID.IsUnknown() ||
+		plan.NodeBalancerID.IsUnknown() || plan.Address.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state ResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.SubnetID.Equal(state.SubnetID) && plan.VPCID.Equal(state.VPCID) &&
+			plan.NodeBalancerID.Equal(state.NodeBalancerID) && plan.Address.Equal(state.Address) {
+			return
+		}
+	}
+
+	nodeBalancerID := helper.FrameworkSafeInt64ToInt(plan.NodeBalancerID.ValueInt64(), &resp.Diagnostics)
+	vpcID := helper.FrameworkSafeInt64ToInt(plan.VPCID.ValueInt64(), &resp.Diagnostics)
+	subnetID := helper.FrameworkSafeInt64ToInt(plan.SubnetID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Validating the VPC subnet of NodeBalancer node", map[string]any{
+		"vpc_id":    vpcID,
+		"subnet_id": subnetID,
+	})
+
+	if err := validateNodeSubnet(
+		ctx, r.Meta.Client, nodeBalancerID, vpcID, subnetID, plan.Address.ValueString(),
+	); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("subnet_id"), "Invalid VPC Subnet", err.Error())
+	}
+}
+
+func (r *Resource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: upgradeResourceStateV0,
+		},
+	}
+}
+
+// upgradeResourceStateV0 decodes a state written by the SDKv2 implementation of
+// this resource from its raw JSON, since attributes were added to it without a
+// version bump.
+func upgradeResourceStateV0(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError(
+			"Failed to Upgrade State",
+			"The prior state of this linode_nodebalancer_node is not stored as JSON.",
+		)
+		return
+	}
+
+	var prior resourceModelV0
+	if err := json.Unmarshal(req.RawState.JSON, &prior); err != nil {
+		resp.Diagnostics.AddError("Failed to Upgrade State", err.Error())
+		return
+	}
+
+	data := prior.upgrade()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// waitForHealthy waits for the node to be reported as UP and returns its latest state.
+func waitForHealthy(
+	ctx context.Context,
+	client *linodego.Client,
+	nodeBalancerID, configID, id int,
+	timeout time.Duration,
+	diags *diag.Diagnostics,
+) *linodego.NodeBalancerNode {
+	tflog.Debug(ctx, "Waiting for NodeBalancer node to become healthy")
+
+	node, err := helper.WaitForNodeBalancerNodeHealthy(ctx, *client, nodeBalancerID, configID, id, timeout)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to Wait for NodeBalancer Node %d to Become Healthy", id),
+			err.Error(),
+		)
+	}
+
+	return node
+}
+
+func getNodeIDs(data ResourceModel, diags *diag.Diagnostics) (int, int, int) {
+	id := helper.FrameworkSafeStringToInt(data.ID.ValueString(), diags)
+	nodeBalancerID := helper.FrameworkSafeInt64ToInt(data.NodeBalancerID.ValueInt64(), diags)
+	configID := helper.FrameworkSafeInt64ToInt(data.ConfigID.ValueInt64(), diags)
+	return nodeBalancerID, configID, id
+}
+
+func populateLogAttributes(ctx context.Context, data ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"nodebalancer_id": data.NodeBalancerID.ValueInt64(),
+		"config_id":       data.ConfigID.ValueInt64(),
+		"node_id":         data.ID.ValueString(),
+	})
+}
@@ -0,0 +1,106 @@
+package nbnode
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var frameworkResourceSchema = schema.Schema{
+	Version: 1,
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the NodeBalancer node.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"nodebalancer_id": schema.Int64Attribute{
+			Description: "The ID of the NodeBalancer to access.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"config_id": schema.Int64Attribute{
+			Description: "The ID of the NodeBalancerConfig to access.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"label": schema.StringAttribute{
+			Description: "The label for this node. This is for display purposes only.",
+			Required:    true,
+		},
+		"weight": schema.Int64Attribute{
+			Description: "Used when picking a backend to serve a request and is not pinned to a single backend " +
+				"yet. Nodes with a higher weight will receive more traffic. (1-255)",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 255),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"mode": schema.StringAttribute{
+			Description: "The mode this NodeBalancer should use when sending traffic to this backend. If set to " +
+				"`accept` this backend is accepting traffic. If set to `reject` this backend will not receive " +
+				"traffic. If set to `drain` this backend will not receive new traffic, but connections already " +
+				"pinned to it will continue to be routed to it. If set to `backup` this backend will only accept " +
+				"traffic if all other nodes are down.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("accept", "reject", "drain", "backup"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"address": schema.StringAttribute{
+			Description: "The private IP Address and port (IP:PORT) where this backend can be reached. " +
+				"This must be a private IP address, or a VPC IP address of the subnet given in subnet_id.",
+			Required: true,
+		},
+		"subnet_id": schema.Int64Attribute{
+			Description: "The ID of the VPC subnet the address of this node belongs to. Required when the " +
+				"address is a VPC IP address; the subnet must be in the same region as the NodeBalancer.",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("vpc_id")),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"vpc_id": schema.Int64Attribute{
+			Description: "The ID of the VPC of subnet_id, used to look up the subnet when planning.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("subnet_id")),
+			},
+		},
+		"wait_for_healthy": schema.BoolAttribute{
+			Description: "If true, creating or updating this node waits until its health checks report it as " +
+				"UP, bounded by the create and update timeouts.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"status": schema.StringAttribute{
+			Description: "The current status of this node, based on the configured checks of its NodeBalancer " +
+				"Config. (unknown, UP, DOWN)",
+			Computed: true,
+		},
+	},
+}
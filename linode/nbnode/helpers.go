@@ -0,0 +1,147 @@
+package nbnode
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// nodeCreateOptions extends the linodego create options with the VPC subnet
+// of the node's address.
+// NOTE: subnet_id is not yet supported by linodego.
+type nodeCreateOptions struct {
+	linodego.NodeBalancerNodeCreateOptions
+	SubnetID int `json:"subnet_id,omitempty"`
+}
+
+// nodeUpdateOptions extends the linodego update options with the VPC subnet
+// of the node's address.
+// NOTE: subnet_id is not yet supported by linodego.
+type nodeUpdateOptions struct {
+	linodego.NodeBalancerNodeUpdateOptions
+	SubnetID int `json:"subnet_id,omitempty"`
+}
+
+// nodeBalancerNode extends the linodego node with the VPC subnet of its address.
+// NOTE: subnet_id is not yet supported by linodego.
+type nodeBalancerNode struct {
+	linodego.NodeBalancerNode
+	SubnetID int `json:"subnet_id"`
+}
+
+func getNodeBalancerNode(
+	ctx context.Context,
+	client *linodego.Client,
+	nodeBalancerID, configID, id int,
+) (*nodeBalancerNode, error) {
+	return helper.DoAPIRequest[nodeBalancerNode](
+		ctx,
+		client,
+		http.MethodGet,
+		fmt.Sprintf("nodebalancers/%d/configs/%d/nodes/%d", nodeBalancerID, configID, id),
+		nil,
+	)
+}
+
+func createNodeBalancerNode(
+	ctx context.Context,
+	client *linodego.Client,
+	nodeBalancerID, configID int,
+	opts nodeCreateOptions,
+) (*linodego.NodeBalancerNode, error) {
+	if opts.SubnetID == 0 {
+		return client.CreateNodeBalancerNode(ctx, nodeBalancerID, configID, opts.NodeBalancerNodeCreateOptions)
+	}
+
+	return helper.DoAPIRequest[linodego.NodeBalancerNode](
+		ctx,
+		client,
+		http.MethodPost,
+		fmt.Sprintf("nodebalancers/%d/configs/%d/nodes", nodeBalancerID, configID),
+		opts,
+	)
+}
+
+func updateNodeBalancerNode(
+	ctx context.Context,
+	client *linodego.Client,
+	nodeBalancerID, configID, id int,
+	opts nodeUpdateOptions,
+) (*linodego.NodeBalancerNode, error) {
+	if opts.SubnetID == 0 {
+		return client.UpdateNodeBalancerNode(ctx, nodeBalancerID, configID, id, opts.NodeBalancerNodeUpdateOptions)
+	}
+
+	return helper.DoAPIRequest[linodego.NodeBalancerNode](
+		ctx,
+		client,
+		http.MethodPut,
+		fmt.Sprintf("nodebalancers/%d/configs/%d/nodes/%d", nodeBalancerID, configID, id),
+		opts,
+	)
+}
+
+// validateNodeSubnet checks that the subnet exists in the given VPC, that the VPC
+// is in the same region as the NodeBalancer and that the address of the node is
+// within the subnet.
+func validateNodeSubnet(
+	ctx context.Context,
+	client *linodego.Client,
+	nodeBalancerID, vpcID, subnetID int,
+	address string,
+) error {
+	nodeBalancer, err := client.GetNodeBalancer(ctx, nodeBalancerID)
+	if err != nil {
+		return fmt.Errorf("failed to get NodeBalancer %d: %w", nodeBalancerID, err)
+	}
+
+	vpc, err := client.GetVPC(ctx, vpcID)
+	if err != nil {
+		return fmt.Errorf("failed to get VPC %d: %w", vpcID, err)
+	}
+
+	var subnet *linodego.VPCSubnet
+
+	for i := range vpc.Subnets {
+		if vpc.Subnets[i].ID == subnetID {
+			subnet = &vpc.Subnets[i]
+		}
+	}
+
+	if subnet == nil {
+		return fmt.Errorf("VPC subnet %d was not found in VPC %d", subnetID, vpcID)
+	}
+
+	if vpc.Region != nodeBalancer.Region {
+		return fmt.Errorf(
+			"VPC subnet %d is in region %s, but NodeBalancer %d is in region %s",
+			subnetID, vpc.Region, nodeBalancerID, nodeBalancer.Region,
+		)
+	}
+
+	prefix, err := netip.ParsePrefix(subnet.IPv4)
+	if err != nil {
+		return fmt.Errorf("failed to parse IPv4 range %q of VPC subnet %d: %w", subnet.IPv4, subnetID, err)
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("failed to parse address %q: %w", address, err)
+	}
+
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("failed to parse address %q: %w", address, err)
+	}
+
+	if !prefix.Contains(ip) {
+		return fmt.Errorf("address %s is not within the IPv4 range %s of VPC subnet %d", ip, prefix, subnetID)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+//go:build unit
+
+package nbnode
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateNodeSubnet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch strings.TrimPrefix(r.URL.Path, "/v4") {
+		case "/nodebalancers/10":
+			_, _ = w.Write([]byte(`{"id": 10, "region": "us-east"}`))
+		case "/vpcs/1":
+			_, _ = w.Write([]byte(`{"id": 1, "region": "us-east", "subnets": [{"id": 100, "ipv4": "10.0.4.0/24"}]}`))
+		case "/vpcs/2":
+			_, _ = w.Write([]byte(`{"id": 2, "region": "us-mia", "subnets": [{"id": 200, "ipv4": "10.0.4.0/24"}]}`))
+		case "/nodebalancers/10/configs/20/nodes/30":
+			_, _ = w.Write([]byte(`{"id": 30, "address": "10.0.4.150:80", "config_id": 20, "nodebalancer_id": 10, "subnet_id": 100}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	ctx := context.Background()
+
+	assert.NoError(t, validateNodeSubnet(ctx, &client, 10, 1, 100, "10.0.4.150:80"))
+	assert.ErrorContains(t,
+		validateNodeSubnet(ctx, &client, 10, 1, 100, "10.0.5.150:80"),
+		"address 10.0.5.150 is not within the IPv4 range 10.0.4.0/24 of VPC subnet 100",
+	)
+	assert.ErrorContains(t,
+		validateNodeSubnet(ctx, &client, 10, 2, 200, "10.0.4.150:80"),
+		"VPC subnet 200 is in region us-mia, but NodeBalancer 10 is in region us-east",
+	)
+	assert.ErrorContains(t,
+		validateNodeSubnet(ctx, &client, 10, 1, 200, "10.0.4.150:80"),
+		"VPC subnet 200 was not found in VPC 1",
+	)
+	assert.ErrorContains(t,
+		validateNodeSubnet(ctx, &client, 10, 3, 100, "10.0.4.150:80"),
+		"failed to get VPC 3",
+	)
+	assert.ErrorContains(t,
+		validateNodeSubnet(ctx, &client, 10, 1, 100, "10.0.4.150"),
+		"failed to parse address",
+	)
+	assert.ErrorContains(t,
+		validateNodeSubnet(ctx, &client, 11, 1, 100, "10.0.4.150:80"),
+		"failed to get NodeBalancer 11",
+	)
+
+	node, err := getNodeBalancerNode(ctx, &client, 10, 20, 30)
+	require.NoError(t, err)
+	assert.Equal(t, 30, node.ID)
+	assert.Equal(t, 100, node.SubnetID)
+}
+
+func TestNodeCreateOptions(t *testing.T) {
+	opts := nodeCreateOptions{
+		NodeBalancerNodeCreateOptions: linodego.NodeBalancerNodeCreateOptions{
+			Address: "10.0.4.150:80",
+			Label:   "node",
+		},
+		SubnetID: 100,
+	}
+
+	body, err := json.Marshal(opts)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"address": "10.0.4.150:80", "label": "node", "subnet_id": 100}`, string(body))
+
+	opts.SubnetID = 0
+
+	body, err = json.Marshal(opts)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"address": "10.0.4.150:80", "label": "node"}`, string(body))
+}
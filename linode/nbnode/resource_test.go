@@ -62,6 +62,67 @@ func TestAccResourceNodeBalancerNode_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceNodeBalancerNode_waitForHealthy(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_nodebalancer_node.foonode"
+	nodeName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreventPostDestroyRefresh: true,
+		PreCheck:                  func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories:  acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:              checkNodeBalancerNodeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.WaitForHealthy(t, nodeName, testRegion, acctest.RandString(12)),
+				Check: resource.ComposeTestCheckFunc(
+					checkNodeBalancerNodeExists,
+					resource.TestCheckResourceAttr(resName, "wait_for_healthy", "true"),
+					resource.TestCheckResourceAttr(resName, "status", "UP"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNodeBalancerNode_vpc(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_nodebalancer_node.foonode"
+	nodeName := acctest.RandomWithPrefix("tf-test")
+
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"nodebalancers", "vpcs"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreventPostDestroyRefresh: true,
+		PreCheck:                  func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories:  acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:              checkNodeBalancerNodeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.VPC(t, nodeName, region, acctest.RandString(12)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "address", "10.0.4.150:80"),
+					resource.TestCheckResourceAttrPair(resName, "subnet_id", "linode_vpc_subnet.foobar", "id"),
+					resource.TestCheckResourceAttrPair(resName, "vpc_id", "linode_vpc.foobar", "id"),
+					resource.TestCheckResourceAttrSet(resName, "status"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"vpc_id"},
+				ImportStateIdFunc:       importResourceStateID,
+			},
+		},
+	})
+}
+
 func TestAccResourceNodeBalancerNode_update(t *testing.T) {
 	t.Parallel()
 
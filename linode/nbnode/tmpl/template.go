@@ -80,3 +80,43 @@ func DataBasic(t *testing.T, nodebalancer, region string, rootPass string) strin
 			},
 		})
 }
+
+func WaitForHealthy(t *testing.T, nodebalancer, region string, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"nodebalancer_node_wait_for_healthy",
+		TemplateData{
+			Label: nodebalancer,
+			Instance: InstanceTemplateData{
+				Label:    nodebalancer,
+				PubKey:   acceptance.PublicKeyMaterial,
+				Region:   region,
+				RootPass: rootPass,
+			},
+			Config: config.TemplateData{
+				NodeBalancer: tmpl.TemplateData{
+					Label:  nodebalancer,
+					Region: region,
+				},
+			},
+		})
+}
+
+func VPC(t *testing.T, nodebalancer, region string, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"nodebalancer_node_vpc",
+		TemplateData{
+			Label: nodebalancer,
+			Instance: InstanceTemplateData{
+				Label:    nodebalancer,
+				PubKey:   acceptance.PublicKeyMaterial,
+				Region:   region,
+				RootPass: rootPass,
+			},
+			Config: config.TemplateData{
+				NodeBalancer: tmpl.TemplateData{
+					Label:  nodebalancer,
+					Region: region,
+				},
+			},
+		})
+}
@@ -0,0 +1,48 @@
+//go:build integration
+
+package nbstats_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/nbstats/tmpl"
+)
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"nodebalancers"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccDataSourceNodeBalancerStats_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.linode_nodebalancer_stats.foobar"
+	nodebalancerName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.DataBasic(t, nodebalancerName, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "nodebalancer_id", "linode_nodebalancer.foobar", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "connections.#"),
+					resource.TestCheckResourceAttr(resourceName, "traffic.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "traffic.0.in.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "traffic.0.out.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,82 @@
+package nbstats
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{
+		BaseDataSource: helper.NewBaseDataSource(
+			helper.BaseDataSourceConfig{
+				Name:   "linode_nodebalancer_stats",
+				Schema: &frameworkDatasourceSchema,
+			},
+		),
+	}
+}
+
+type DataSource struct {
+	helper.BaseDataSource
+}
+
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read data.linode_nodebalancer_stats")
+
+	client := d.Meta.Client
+
+	var data DataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeBalancerID := helper.FrameworkSafeInt64ToInt(
+		data.NodeBalancerID.ValueInt64(),
+		&resp.Diagnostics,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "nodebalancer_id", nodeBalancerID)
+
+	tflog.Trace(ctx, "client.GetNodeBalancerStats(...)")
+
+	stats, err := client.GetNodeBalancerStats(ctx, nodeBalancerID)
+	if err != nil {
+		// Stats are unavailable until the NodeBalancer has been up for a while
+		if lerr, ok := err.(*linodego.Error); !ok || lerr.Code != http.StatusBadRequest {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Get Stats of NodeBalancer %d", nodeBalancerID),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddWarning(
+			"NodeBalancer Stats Unavailable",
+			fmt.Sprintf("Stats of NodeBalancer %d are not available yet: %s", nodeBalancerID, err),
+		)
+
+		stats = &linodego.NodeBalancerStats{}
+	}
+
+	data.ParseNodeBalancerStats(stats, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,50 @@
+package nbstats
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var pointObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"time":  types.StringType,
+		"value": types.Float64Type,
+	},
+}
+
+var trafficObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"in":  types.ListType{ElemType: pointObjectType},
+		"out": types.ListType{ElemType: pointObjectType},
+	},
+}
+
+var frameworkDatasourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"nodebalancer_id": schema.Int64Attribute{
+			Description: "The ID of the NodeBalancer to get the stats of.",
+			Required:    true,
+		},
+		"id": schema.StringAttribute{
+			Description: "Unique identifier for this DataSource.",
+			Computed:    true,
+		},
+		"title": schema.StringAttribute{
+			Description: "The title of the stats.",
+			Computed:    true,
+		},
+		"connections": schema.ListAttribute{
+			Description: "The number of connections to this NodeBalancer over the last 24 hours, in 5 minute " +
+				"intervals.",
+			Computed:    true,
+			ElementType: pointObjectType,
+		},
+		"traffic": schema.ListAttribute{
+			Description: "The incoming and outgoing traffic of this NodeBalancer over the last 24 hours, in bits " +
+				"per second and 5 minute intervals.",
+			Computed:    true,
+			ElementType: trafficObjectType,
+		},
+	},
+}
@@ -0,0 +1,81 @@
+package nbstats
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type DataSourceModel struct {
+	NodeBalancerID types.Int64  `tfsdk:"nodebalancer_id"`
+	ID             types.String `tfsdk:"id"`
+	Title          types.String `tfsdk:"title"`
+	Connections    types.List   `tfsdk:"connections"`
+	Traffic        types.List   `tfsdk:"traffic"`
+}
+
+func (data *DataSourceModel) ParseNodeBalancerStats(
+	stats *linodego.NodeBalancerStats, diags *diag.Diagnostics,
+) {
+	data.ID = types.StringValue(strconv.FormatInt(data.NodeBalancerID.ValueInt64(), 10))
+	data.Title = types.StringValue(stats.Title)
+
+	data.Connections = flattenPoints(stats.Data.Connections, diags)
+	if diags.HasError() {
+		return
+	}
+
+	traffic := map[string]attr.Value{
+		"in":  flattenPoints(stats.Data.Traffic.In, diags),
+		"out": flattenPoints(stats.Data.Traffic.Out, diags),
+	}
+	if diags.HasError() {
+		return
+	}
+
+	trafficObj, d := types.ObjectValue(trafficObjectType.AttrTypes, traffic)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	result, d := types.ListValue(trafficObjectType, []attr.Value{trafficObj})
+	diags.Append(d...)
+
+	data.Traffic = result
+}
+
+// flattenPoints converts a series of [timestamp, value] pairs returned by the API,
+// where the timestamp is in milliseconds since the epoch.
+func flattenPoints(points [][]float64, diags *diag.Diagnostics) types.List {
+	if points == nil {
+		points = [][]float64{}
+	}
+
+	return helper.GenericSliceToList(points, pointObjectType, flattenPoint, diags)
+}
+
+func flattenPoint(point []float64) (*basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(point) != 2 {
+		diags.AddError("Unexpected Stats Data Point", "Expected a [timestamp, value] pair.")
+		return nil, diags
+	}
+
+	result := map[string]attr.Value{
+		"time":  types.StringValue(time.UnixMilli(int64(point[0])).UTC().Format(time.RFC3339)),
+		"value": types.Float64Value(point[1]),
+	}
+
+	obj, d := types.ObjectValue(pointObjectType.AttrTypes, result)
+	diags.Append(d...)
+
+	return &obj, diags
+}
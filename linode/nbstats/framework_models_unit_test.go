@@ -0,0 +1,84 @@
+//go:build unit
+
+package nbstats
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pointModel struct {
+	Time  types.String  `tfsdk:"time"`
+	Value types.Float64 `tfsdk:"value"`
+}
+
+type trafficModel struct {
+	In  []pointModel `tfsdk:"in"`
+	Out []pointModel `tfsdk:"out"`
+}
+
+func TestParseNodeBalancerStats(t *testing.T) {
+	stats := &linodego.NodeBalancerStats{
+		Title: "mynodebalancer (12345) - day (5 min avg)",
+		Data: linodego.NodeBalancerStatsData{
+			Connections: [][]float64{{1700000000000, 12}, {1700000300000, 7.5}},
+			Traffic: linodego.StatsTraffic{
+				In:  [][]float64{{1700000000000, 1024}},
+				Out: [][]float64{{1700000000000, 2048}},
+			},
+		},
+	}
+
+	data := DataSourceModel{NodeBalancerID: types.Int64Value(12345)}
+
+	var diags diag.Diagnostics
+	data.ParseNodeBalancerStats(stats, &diags)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, types.StringValue("12345"), data.ID)
+	assert.Equal(t, types.StringValue(stats.Title), data.Title)
+
+	var connections []pointModel
+	require.False(t, data.Connections.ElementsAs(context.Background(), &connections, false).HasError())
+	require.Len(t, connections, 2)
+	assert.Equal(t, "2023-11-14T22:13:20Z", connections[0].Time.ValueString())
+	assert.Equal(t, 12.0, connections[0].Value.ValueFloat64())
+	assert.Equal(t, 7.5, connections[1].Value.ValueFloat64())
+
+	var traffic []trafficModel
+	require.False(t, data.Traffic.ElementsAs(context.Background(), &traffic, false).HasError())
+	require.Len(t, traffic, 1)
+	assert.Equal(t, 1024.0, traffic[0].In[0].Value.ValueFloat64())
+	assert.Equal(t, 2048.0, traffic[0].Out[0].Value.ValueFloat64())
+}
+
+func TestParseNodeBalancerStatsEmpty(t *testing.T) {
+	data := DataSourceModel{NodeBalancerID: types.Int64Value(12345)}
+
+	var diags diag.Diagnostics
+	data.ParseNodeBalancerStats(&linodego.NodeBalancerStats{}, &diags)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Empty(t, data.Connections.Elements())
+	assert.Len(t, data.Traffic.Elements(), 1)
+}
+
+func TestParseNodeBalancerStatsInvalidPoint(t *testing.T) {
+	stats := &linodego.NodeBalancerStats{
+		Data: linodego.NodeBalancerStatsData{
+			Connections: [][]float64{{1700000000000}},
+		},
+	}
+
+	data := DataSourceModel{NodeBalancerID: types.Int64Value(12345)}
+
+	var diags diag.Diagnostics
+	data.ParseNodeBalancerStats(stats, &diags)
+	assert.True(t, diags.HasError())
+}
@@ -0,0 +1,16 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/nb/tmpl"
+)
+
+func DataBasic(t *testing.T, nodebalancer, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"nodebalancer_stats_data_basic", tmpl.TemplateData{
+			Label:  nodebalancer,
+			Region: region,
+		})
+}
@@ -0,0 +1,52 @@
+//go:build integration
+
+package obj_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/obj/tmpl"
+)
+
+func TestAccDataSourceObject_basic(t *testing.T) {
+	t.Parallel()
+
+	dataSourceName := "data.linode_object_storage_object.foobar"
+	content := "testing123"
+	contentSource := acceptance.CreateTempFile(t, "tf-test-obj-data-source", content)
+
+	acceptance.RunTestRetry(t, 6, func(tRetry *acceptance.TRetry) {
+		bucketName := acctest.RandomWithPrefix("tf-test")
+		keyName := acctest.RandomWithPrefix("tf_test")
+
+		resource.Test(tRetry, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.DataBasic(t, bucketName, testCluster, keyName, content, contentSource.Name()),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(dataSourceName, "bucket", bucketName),
+						resource.TestCheckResourceAttr(dataSourceName, "key", "test_basic"),
+						resource.TestCheckResourceAttr(dataSourceName, "body", content),
+						resource.TestCheckResourceAttr(dataSourceName, "content_length", "10"),
+						resource.TestCheckResourceAttrPair(
+							dataSourceName, "etag",
+							"linode_object_storage_object.basic", "etag",
+						),
+						resource.TestCheckResourceAttrSet(dataSourceName, "content_type"),
+						resource.TestCheckResourceAttrSet(dataSourceName, "last_modified"),
+						resource.TestCheckResourceAttrSet(dataSourceName, "endpoint"),
+
+						// The body is only read when requested
+						resource.TestCheckNoResourceAttr("data.linode_object_storage_object.metadata", "body"),
+						resource.TestCheckResourceAttr("data.linode_object_storage_object.metadata", "content_length", "10"),
+					),
+				},
+			},
+		})
+	})
+}
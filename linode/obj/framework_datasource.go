@@ -0,0 +1,186 @@
+package obj
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// maxObjectBodySize is the size limit in bytes of objects whose body is read.
+const maxObjectBodySize = 1 << 20
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{
+		BaseDataSource: helper.NewBaseDataSource(
+			helper.BaseDataSourceConfig{
+				Name:   "linode_object_storage_object",
+				Schema: &frameworkDataSourceSchema,
+			},
+		),
+	}
+}
+
+type DataSource struct {
+	helper.BaseDataSource
+}
+
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read data.linode_object_storage_object")
+
+	var data DataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := data.Bucket.ValueString()
+	cluster := data.Cluster.ValueString()
+	key := data.Key.ValueString()
+
+	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
+		"bucket":     bucket,
+		"cluster":    cluster,
+		"object_key": key,
+	})
+
+	s3client, endpoint, diags, teardownKeysCleanUp := FrameworkS3Connection(
+		ctx,
+		d.Meta,
+		ObjectKeys{
+			AccessKey: data.AccessKey.ValueString(),
+			SecretKey: data.SecretKey.ValueString(),
+		},
+		data.Endpoint.ValueString(),
+		bucket,
+		cluster,
+		"read_only",
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if teardownKeysCleanUp != nil {
+		defer teardownKeysCleanUp()
+	}
+
+	headObjectInput := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if !data.VersionID.IsNull() && !data.VersionID.IsUnknown() {
+		headObjectInput.VersionId = data.VersionID.ValueStringPointer()
+	}
+
+	tflog.Debug(ctx, "getting object metadata", map[string]any{"HeadObjectInput": headObjectInput})
+
+	object, err := s3client.HeadObject(ctx, headObjectInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get object %s in bucket %s", key, bucket),
+			err.Error(),
+		)
+		return
+	}
+
+	var body *string
+
+	if data.IncludeBody.ValueBool() {
+		contentType := aws.ToString(object.ContentType)
+		contentLength := aws.ToInt64(object.ContentLength)
+
+		switch {
+		case !isTextContentType(contentType):
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("include_body"),
+				"Object Body Not Available",
+				fmt.Sprintf(
+					"The body of object %s in bucket %s isn't read since its content type %q isn't text.",
+					key, bucket, contentType,
+				),
+			)
+		case contentLength > maxObjectBodySize:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("include_body"),
+				"Object Body Too Large",
+				fmt.Sprintf(
+					"Object %s in bucket %s is %d bytes, which exceeds the %d bytes limit of the body.",
+					key, bucket, contentLength, maxObjectBodySize,
+				),
+			)
+			return
+		default:
+			body, err = getObjectBody(ctx, s3client, bucket, key, object)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					fmt.Sprintf("Failed to read the body of object %s in bucket %s", key, bucket),
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(data.ParseObject(ctx, object, body)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Endpoint = types.StringValue(endpoint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getObjectBody downloads the body of the given object, pinning the
+// version or entity tag read by HeadObject so the metadata matches.
+func getObjectBody(
+	ctx context.Context,
+	client *s3.Client,
+	bucket, key string,
+	head *s3.HeadObjectOutput,
+) (*string, error) {
+	getObjectInput := &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if head.VersionId != nil {
+		getObjectInput.VersionId = head.VersionId
+	} else {
+		getObjectInput.IfMatch = head.ETag
+	}
+
+	tflog.Debug(ctx, "getting object body", map[string]any{"GetObjectInput": getObjectInput})
+
+	object, err := client.GetObject(ctx, getObjectInput)
+	if err != nil {
+		return nil, err
+	}
+	defer object.Body.Close()
+
+	// The object may have been replaced by a larger one since HeadObject
+	bodyBytes, err := io.ReadAll(io.LimitReader(object.Body, maxObjectBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bodyBytes) > maxObjectBodySize {
+		return nil, fmt.Errorf("the body exceeds the %d bytes limit", maxObjectBodySize)
+	}
+
+	return aws.String(string(bodyBytes)), nil
+}
@@ -0,0 +1,100 @@
+package obj
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var frameworkDataSourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of this object in the format of <bucket>/<key>.",
+			Computed:    true,
+		},
+		"bucket": schema.StringAttribute{
+			Description: "The bucket the object is in.",
+			Required:    true,
+		},
+		"cluster": schema.StringAttribute{
+			Description: "The cluster that the bucket is in.",
+			Required:    true,
+		},
+		"key": schema.StringAttribute{
+			Description: "The name of the object.",
+			Required:    true,
+		},
+		"version_id": schema.StringAttribute{
+			Description: "The version ID of the object. The latest version is read if not specified.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"access_key": schema.StringAttribute{
+			Description: "The S3 access key with access to the target bucket. " +
+				"If not specified, the value will be read from provider-level obj_access_key, " +
+				"or, generated implicitly if obj_use_temp_keys in provider configuration is set.",
+			Optional: true,
+		},
+		"secret_key": schema.StringAttribute{
+			Description: "The S3 secret key with access to the target bucket. " +
+				"If not specified, the value will be read from provider-level obj_secret_key, " +
+				"or, generated implicitly if obj_use_temp_keys in provider configuration is set.",
+			Optional:  true,
+			Sensitive: true,
+		},
+		"endpoint": schema.StringAttribute{
+			Description: "The endpoint for the bucket used for s3 connections.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"include_body": schema.BoolAttribute{
+			Description: "If true, the content of the object is read into body. " +
+				"Only objects with a text content type of up to 1 MiB can be read.",
+			Optional: true,
+		},
+		"body": schema.StringAttribute{
+			Description: "The content of the object. Only set when include_body is true.",
+			Computed:    true,
+		},
+		"cache_control": schema.StringAttribute{
+			Description: "The cache_control configuration of this object.",
+			Computed:    true,
+		},
+		"content_disposition": schema.StringAttribute{
+			Description: "The content disposition configuration of this object.",
+			Computed:    true,
+		},
+		"content_encoding": schema.StringAttribute{
+			Description: "The encoding of the content of this object.",
+			Computed:    true,
+		},
+		"content_language": schema.StringAttribute{
+			Description: "The language metadata of this object.",
+			Computed:    true,
+		},
+		"content_length": schema.Int64Attribute{
+			Description: "The size of the object in bytes.",
+			Computed:    true,
+		},
+		"content_type": schema.StringAttribute{
+			Description: "The MIME type of the content.",
+			Computed:    true,
+		},
+		"etag": schema.StringAttribute{
+			Description: "The entity tag of the object.",
+			Computed:    true,
+		},
+		"last_modified": schema.StringAttribute{
+			Description: "When this object was last modified.",
+			Computed:    true,
+		},
+		"metadata": schema.MapAttribute{
+			Description: "The metadata of this object.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"website_redirect": schema.StringAttribute{
+			Description: "The website redirect location of this object.",
+			Computed:    true,
+		},
+	},
+}
@@ -0,0 +1,60 @@
+//go:build unit
+
+package obj
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetObjectBody(t *testing.T) {
+	var requests []string
+
+	// A fake S3-compatible server addressed in the request path
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Query().Get("versionId")+" "+r.Header.Get("If-Match"))
+
+		switch r.URL.Path {
+		case "/my-bucket/hello.txt":
+			w.Write([]byte("hello world"))
+		case "/my-bucket/large.txt":
+			w.Write([]byte(strings.Repeat("a", maxObjectBodySize+1)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := helper.S3Connection(
+		context.Background(), server.URL, "access", "secret", helper.WithS3PathStyle(true),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := getObjectBody(context.Background(), client, "my-bucket", "hello.txt", &s3.HeadObjectOutput{
+		VersionId: aws.String("v1"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", aws.ToString(body))
+
+	// Unversioned objects are pinned by their entity tag instead
+	_, err = getObjectBody(context.Background(), client, "my-bucket", "hello.txt", &s3.HeadObjectOutput{
+		ETag: aws.String(`"abc"`),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{`v1 `, ` "abc"`}, requests)
+
+	// Objects replaced by larger ones since HeadObject aren't read
+	_, err = getObjectBody(context.Background(), client, "my-bucket", "large.txt", &s3.HeadObjectOutput{})
+	assert.ErrorContains(t, err, "exceeds")
+}
@@ -0,0 +1,106 @@
+package obj
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// textContentTypes are the non-`text/*` content types whose
+// content is exposed as the body of the object.
+var textContentTypes = []string{
+	"application/json",
+	"application/xml",
+	"application/javascript",
+	"application/x-javascript",
+	"application/ecmascript",
+	"application/yaml",
+	"application/x-yaml",
+	"application/x-sh",
+}
+
+type DataSourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Bucket             types.String `tfsdk:"bucket"`
+	Cluster            types.String `tfsdk:"cluster"`
+	Key                types.String `tfsdk:"key"`
+	VersionID          types.String `tfsdk:"version_id"`
+	AccessKey          types.String `tfsdk:"access_key"`
+	SecretKey          types.String `tfsdk:"secret_key"`
+	Endpoint           types.String `tfsdk:"endpoint"`
+	IncludeBody        types.Bool   `tfsdk:"include_body"`
+	Body               types.String `tfsdk:"body"`
+	CacheControl       types.String `tfsdk:"cache_control"`
+	ContentDisposition types.String `tfsdk:"content_disposition"`
+	ContentEncoding    types.String `tfsdk:"content_encoding"`
+	ContentLanguage    types.String `tfsdk:"content_language"`
+	ContentLength      types.Int64  `tfsdk:"content_length"`
+	ContentType        types.String `tfsdk:"content_type"`
+	ETag               types.String `tfsdk:"etag"`
+	LastModified       types.String `tfsdk:"last_modified"`
+	Metadata           types.Map    `tfsdk:"metadata"`
+	WebsiteRedirect    types.String `tfsdk:"website_redirect"`
+}
+
+// ParseObject parses the given object into the data source model.
+// The body is only set if it is not nil.
+func (data *DataSourceModel) ParseObject(
+	ctx context.Context,
+	object *s3.HeadObjectOutput,
+	body *string,
+) diag.Diagnostics {
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.Bucket.ValueString(), data.Key.ValueString()))
+	data.Body = types.StringPointerValue(body)
+	data.CacheControl = types.StringPointerValue(object.CacheControl)
+	data.ContentDisposition = types.StringPointerValue(object.ContentDisposition)
+	data.ContentEncoding = types.StringPointerValue(object.ContentEncoding)
+	data.ContentLanguage = types.StringPointerValue(object.ContentLanguage)
+	data.ContentLength = types.Int64PointerValue(object.ContentLength)
+	data.ContentType = types.StringPointerValue(object.ContentType)
+	data.ETag = types.StringValue(strings.Trim(aws.ToString(object.ETag), `"`))
+	data.VersionID = types.StringPointerValue(object.VersionId)
+	data.WebsiteRedirect = types.StringPointerValue(object.WebsiteRedirectLocation)
+
+	data.LastModified = types.StringNull()
+	if object.LastModified != nil {
+		data.LastModified = types.StringValue(object.LastModified.Format(time.RFC3339))
+	}
+
+	metadata, diags := types.MapValueFrom(ctx, types.StringType, flattenObjectMetadata(object.Metadata))
+	if diags.HasError() {
+		return diags
+	}
+	data.Metadata = metadata
+
+	return nil
+}
+
+// isTextContentType returns whether objects of the given
+// content type can be exposed as a string.
+func isTextContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	if strings.HasPrefix(mediaType, "text/") ||
+		strings.HasSuffix(mediaType, "+json") ||
+		strings.HasSuffix(mediaType, "+xml") {
+		return true
+	}
+
+	for _, textType := range textContentTypes {
+		if mediaType == textType {
+			return true
+		}
+	}
+
+	return false
+}
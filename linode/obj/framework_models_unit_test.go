@@ -0,0 +1,68 @@
+//go:build unit
+
+package obj
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseObject(t *testing.T) {
+	lastModified := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	object := &s3.HeadObjectOutput{
+		CacheControl:  aws.String("max-age=60"),
+		ContentLength: aws.Int64(11),
+		ContentType:   aws.String("text/plain"),
+		ETag:          aws.String(`"5eb63bbbe01eeed093cb22bb8f5acdc3"`),
+		LastModified:  &lastModified,
+		Metadata:      map[string]string{"Foo": "bar"},
+		VersionId:     aws.String("v1"),
+	}
+
+	data := DataSourceModel{
+		Bucket: types.StringValue("my-bucket"),
+		Key:    types.StringValue("hello.txt"),
+	}
+
+	diags := data.ParseObject(context.Background(), object, aws.String("hello world"))
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, "my-bucket/hello.txt", data.ID.ValueString())
+	assert.Equal(t, "hello world", data.Body.ValueString())
+	assert.Equal(t, "max-age=60", data.CacheControl.ValueString())
+	assert.Equal(t, int64(11), data.ContentLength.ValueInt64())
+	assert.Equal(t, "text/plain", data.ContentType.ValueString())
+	assert.Equal(t, "5eb63bbbe01eeed093cb22bb8f5acdc3", data.ETag.ValueString())
+	assert.Equal(t, "2024-01-02T03:04:05Z", data.LastModified.ValueString())
+	assert.Equal(t, "v1", data.VersionID.ValueString())
+	assert.True(t, data.ContentEncoding.IsNull())
+
+	expectedMetadata := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"foo": types.StringValue("bar"),
+	})
+	assert.True(t, expectedMetadata.Equal(data.Metadata))
+
+	diags = data.ParseObject(context.Background(), object, nil)
+	assert.False(t, diags.HasError())
+	assert.True(t, data.Body.IsNull())
+}
+
+func TestIsTextContentType(t *testing.T) {
+	assert.True(t, isTextContentType("text/plain"))
+	assert.True(t, isTextContentType("text/html; charset=utf-8"))
+	assert.True(t, isTextContentType("application/json"))
+	assert.True(t, isTextContentType("application/ld+json"))
+	assert.True(t, isTextContentType("image/svg+xml"))
+
+	assert.False(t, isTextContentType("application/octet-stream"))
+	assert.False(t, isTextContentType("image/png"))
+	assert.False(t, isTextContentType(""))
+}
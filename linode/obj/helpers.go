@@ -2,17 +2,37 @@ package obj
 
 import (
 	"context"
+	"crypto/md5" // #nosec G501 -- S3 ETags are md5 based
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
+	s3manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
+const (
+	// defaultMultipartPartSizeMiB is the default and minimum size in MiB
+	// of a part in a multipart upload.
+	defaultMultipartPartSizeMiB = int(s3manager.MinUploadPartSize / bytesPerMiB)
+
+	// uploadPartMaxAttempts is the maximum number of attempts for
+	// uploading a single part of a multipart upload.
+	uploadPartMaxAttempts = 5
+
+	bytesPerMiB = 1024 * 1024
+)
+
 type ObjectKeys struct {
 	AccessKey string
 	SecretKey string
@@ -26,71 +46,11 @@ func populateLogAttributes(ctx context.Context, d *schema.ResourceData) context.
 	})
 }
 
-// getObjKeysFromProvider gets obj_access_key and obj_secret_key from provider configuration.
-// Return whether both of the keys exist.
-func getObjKeysFromProvider(
-	keys ObjectKeys,
-	config *helper.Config,
-) (ObjectKeys, bool) {
-	keys.AccessKey = config.ObjAccessKey
-	keys.SecretKey = config.ObjSecretKey
-
-	return keys, checkObjKeysConfigured(keys)
-}
-
-// createTempKeys creates temporary Object Storage Keys to use.
-// The temporary keys are scoped only to the target cluster and bucket with limited permissions.
-// Keys only exist for the duration of the apply time.
-func createTempKeys(
-	ctx context.Context,
-	client linodego.Client,
-	bucket, cluster, permissions string,
-) (*linodego.ObjectStorageKey, diag.Diagnostics) {
-	tflog.Debug(ctx, "Create temporary object storage access keys implicitly.")
-
-	createOpts := linodego.ObjectStorageKeyCreateOptions{
-		Label: fmt.Sprintf("temp_%s_%v", bucket, time.Now().Unix()),
-		BucketAccess: &[]linodego.ObjectStorageKeyBucketAccess{{
-			BucketName:  bucket,
-			Cluster:     cluster,
-			Permissions: permissions,
-		}},
-	}
-
-	tflog.Debug(ctx, "client.CreateObjectStorageKey(...)", map[string]interface{}{
-		"options": createOpts,
-	})
-
-	keys, err := client.CreateObjectStorageKey(ctx, createOpts)
-	if err != nil {
-		return nil, diag.FromErr(err)
-	}
-
-	return keys, nil
-}
-
 // checkObjKeysConfigured checks whether AccessKey and SecretKey both exist.
 func checkObjKeysConfigured(keys ObjectKeys) bool {
 	return keys.AccessKey != "" && keys.SecretKey != ""
 }
 
-// cleanUpTempKeys deleted the temporarily created object keys.
-func cleanUpTempKeys(
-	ctx context.Context,
-	client linodego.Client,
-	keyId int,
-) {
-	tflog.Trace(ctx, "Clean up temporary keys: client.DeleteObjectStorageKey(...)", map[string]interface{}{
-		"key_id": keyId,
-	})
-
-	if err := client.DeleteObjectStorageKey(ctx, keyId); err != nil {
-		tflog.Warn(ctx, "Failed to clean up temporary object storage keys", map[string]interface{}{
-			"details": err,
-		})
-	}
-}
-
 // GetObjKeys gets object access_key and secret_key in the following order:
 // 1) Whether the keys are specified in the resource configuration;
 // 2) Whether the provider-level object keys exist;
@@ -98,40 +58,85 @@ func cleanUpTempKeys(
 func GetObjKeys(
 	ctx context.Context,
 	d *schema.ResourceData,
-	config *helper.Config,
-	client linodego.Client,
+	meta *helper.ProviderMeta,
 	bucket, cluster, permission string,
 ) (ObjectKeys, diag.Diagnostics, func()) {
-	var teardownTempKeysCleanUp func() = nil
-
 	objKeys := ObjectKeys{
 		AccessKey: d.Get("access_key").(string),
 		SecretKey: d.Get("secret_key").(string),
 	}
 
-	if !checkObjKeysConfigured(objKeys) {
-		// If object keys don't exist in the resource configuration, firstly look for the keys from provider configuration
-		if providerKeys, ok := getObjKeysFromProvider(objKeys, config); ok {
-			objKeys = providerKeys
-		} else if config.ObjUseTempKeys {
-			// Implicitly create temporary object storage keys
-			keys, diag := createTempKeys(ctx, client, bucket, cluster, permission)
-			if diag != nil {
-				return objKeys, diag, nil
-			}
-			objKeys.AccessKey = keys.AccessKey
-			objKeys.SecretKey = keys.SecretKey
-			teardownTempKeysCleanUp = func() { cleanUpTempKeys(ctx, client, keys.ID) }
-		} else {
-			return objKeys, diag.Errorf(
-				"access_key and secret_key are required.",
-			), nil
-		}
+	providerKeys := ObjectKeys{
+		AccessKey: meta.Config.ObjAccessKey,
+		SecretKey: meta.Config.ObjSecretKey,
+	}
+
+	objKeys, teardownTempKeysCleanUp, err := resolveObjKeys(
+		ctx, objKeys, providerKeys, meta.ObjTempKeys, bucket, cluster, permission,
+	)
+	if err != nil {
+		return objKeys, diag.FromErr(err), nil
 	}
 
 	return objKeys, nil, teardownTempKeysCleanUp
 }
 
+// FrameworkGetObjKeys is the framework equivalent of GetObjKeys,
+// taking the keys specified in the resource or data source configuration.
+func FrameworkGetObjKeys(
+	ctx context.Context,
+	objKeys ObjectKeys,
+	meta *helper.FrameworkProviderMeta,
+	bucket, cluster, permission string,
+) (ObjectKeys, fwdiag.Diagnostics, func()) {
+	providerKeys := ObjectKeys{
+		AccessKey: meta.Config.ObjAccessKey.ValueString(),
+		SecretKey: meta.Config.ObjSecretKey.ValueString(),
+	}
+
+	objKeys, teardownTempKeysCleanUp, err := resolveObjKeys(
+		ctx, objKeys, providerKeys, meta.ObjTempKeys, bucket, cluster, permission,
+	)
+	if err != nil {
+		var diags fwdiag.Diagnostics
+		diags.AddError("Failed to get object storage keys", err.Error())
+		return objKeys, diags, nil
+	}
+
+	return objKeys, nil, teardownTempKeysCleanUp
+}
+
+func resolveObjKeys(
+	ctx context.Context,
+	objKeys, providerKeys ObjectKeys,
+	tempKeys *helper.ObjTempKeyBroker,
+	bucket, cluster, permission string,
+) (ObjectKeys, func(), error) {
+	if checkObjKeysConfigured(objKeys) {
+		return objKeys, nil, nil
+	}
+
+	// If object keys don't exist in the resource configuration, firstly look for the keys from provider configuration
+	if checkObjKeysConfigured(providerKeys) {
+		return providerKeys, nil, nil
+	}
+
+	if tempKeys == nil {
+		return objKeys, nil, errors.New("access_key and secret_key are required")
+	}
+
+	// Implicitly use temporary object storage keys shared within the provider run
+	keys, releaseTempKeys, err := tempKeys.Acquire(ctx, bucket, cluster, permission)
+	if err != nil {
+		return objKeys, nil, err
+	}
+
+	objKeys.AccessKey = keys.AccessKey
+	objKeys.SecretKey = keys.SecretKey
+
+	return objKeys, releaseTempKeys, nil
+}
+
 func putObjectWithRetries(
 	ctx context.Context,
 	s3client *s3.Client,
@@ -170,3 +175,161 @@ func putObjectWithRetries(
 		}
 	}
 }
+
+// uploadObjectMultipart uploads the object using the S3 transfer manager,
+// splitting the body into parts of the given size which are uploaded
+// concurrently and retried individually.
+func uploadObjectMultipart(
+	ctx context.Context,
+	s3client *s3.Client,
+	putInput *s3.PutObjectInput,
+	partSize int64,
+	concurrency int,
+) error {
+	tflog.Debug(ctx, "Attempting to upload object in multiple parts", map[string]any{
+		"part_size":   partSize,
+		"concurrency": concurrency,
+	})
+
+	uploader := s3manager.NewUploader(s3client, func(u *s3manager.Uploader) {
+		u.PartSize = partSize
+		u.Concurrency = concurrency
+		u.ClientOptions = append(u.ClientOptions, func(o *s3.Options) {
+			o.RetryMaxAttempts = uploadPartMaxAttempts
+		})
+	})
+
+	if _, err := uploader.Upload(ctx, putInput); err != nil {
+		return fmt.Errorf("failed to upload the object: %w", err)
+	}
+
+	return nil
+}
+
+// computeSourceETags computes both the md5 digest of the given file and the
+// ETag S3 assigns to it when uploaded in parts of the given size, reading
+// the file only once.
+func computeSourceETags(path string, partSize int64) (string, string, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return "", "", err
+	}
+	defer file.Close()
+
+	fileHash := md5.New() // #nosec G401 -- S3 ETags are md5 based
+	reader := io.TeeReader(file, fileHash)
+
+	var partDigests []byte
+	parts := 0
+
+	for {
+		hash := md5.New() // #nosec G401 -- S3 ETags are md5 based
+
+		n, err := io.CopyN(hash, reader, partSize)
+		if n > 0 {
+			partDigests = append(partDigests, hash.Sum(nil)...)
+			parts++
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	digest := md5.Sum(partDigests) // #nosec G401 -- S3 ETags are md5 based
+
+	return hex.EncodeToString(fileHash.Sum(nil)), fmt.Sprintf("%s-%d", hex.EncodeToString(digest[:]), parts), nil
+}
+
+// ComputeFileMD5 computes the hex encoded md5 digest of the given file.
+func ComputeFileMD5(path string) (string, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New() // #nosec G401 -- S3 ETags are md5 based
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// IsMultipartETag returns whether the given ETag belongs to an object
+// uploaded using a multipart upload.
+func IsMultipartETag(etag string) bool {
+	return strings.Contains(etag, "-")
+}
+
+// multipartETagMatchesSource returns whether the multipart ETag of an uploaded
+// object corresponds to the source file whose md5 digest is configured as etag.
+func multipartETagMatchesSource(
+	remoteETag, configuredETag, source string,
+	partSize int64,
+) (bool, error) {
+	if configuredETag == "" || source == "" || !IsMultipartETag(remoteETag) || IsMultipartETag(configuredETag) {
+		return false, nil
+	}
+
+	sourceMD5, sourceETag, err := computeSourceETags(source, partSize)
+	if err != nil {
+		return false, err
+	}
+
+	return strings.EqualFold(sourceMD5, configuredETag) && strings.EqualFold(sourceETag, remoteETag), nil
+}
+
+// FrameworkS3Connection resolves the object storage keys and the endpoint of the
+// given bucket and creates an S3 client for use in framework data sources.
+// The endpoint is computed from the bucket if empty, and the provider-level
+// obj_endpoint_override takes precedence over it.
+func FrameworkS3Connection(
+	ctx context.Context,
+	meta *helper.FrameworkProviderMeta,
+	objKeys ObjectKeys,
+	endpoint, bucket, cluster, permission string,
+) (*s3.Client, string, fwdiag.Diagnostics, func()) {
+	objKeys, diags, teardownKeysCleanUp := FrameworkGetObjKeys(
+		ctx, objKeys, meta, bucket, cluster, permission,
+	)
+	if diags.HasError() {
+		return nil, "", diags, nil
+	}
+
+	cleanUp := func() {
+		if teardownKeysCleanUp != nil {
+			teardownKeysCleanUp()
+		}
+	}
+
+	if override := meta.Config.ObjEndpointOverride.ValueString(); override != "" {
+		endpoint = override
+	}
+
+	if endpoint == "" {
+		var err error
+		if endpoint, err = helper.FrameworkComputeS3Endpoint(ctx, meta.Client, cluster, bucket); err != nil {
+			cleanUp()
+			diags.AddError("Failed to compute object storage endpoint", err.Error())
+			return nil, "", diags, nil
+		}
+	}
+
+	s3client, err := helper.S3Connection(
+		ctx, endpoint, objKeys.AccessKey, objKeys.SecretKey,
+		helper.WithS3PathStyle(meta.Config.ObjUsePathStyle.ValueBool()),
+	)
+	if err != nil {
+		cleanUp()
+		diags.AddError("Failed to create object storage client", err.Error())
+		return nil, "", diags, nil
+	}
+
+	return s3client, endpoint, diags, teardownKeysCleanUp
+}
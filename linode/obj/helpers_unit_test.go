@@ -0,0 +1,82 @@
+//go:build unit
+
+package obj
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestSource(t *testing.T, content []byte) string {
+	path := filepath.Join(t.TempDir(), "source")
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestComputeSourceETags(t *testing.T) {
+	content := []byte("abcdefghij")
+	source := createTestSource(t, content)
+
+	partDigests := make([]byte, 0)
+	for _, part := range [][]byte{content[0:4], content[4:8], content[8:10]} {
+		digest := md5.Sum(part)
+		partDigests = append(partDigests, digest[:]...)
+	}
+	digest := md5.Sum(partDigests)
+	expected := hex.EncodeToString(digest[:]) + "-3"
+
+	fileDigest := md5.Sum(content)
+
+	sourceMD5, etag, err := computeSourceETags(source, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, hex.EncodeToString(fileDigest[:]), sourceMD5)
+	assert.Equal(t, expected, etag)
+
+	// Exactly one full part
+	_, etag, err = computeSourceETags(source, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Regexp(t, "-1$", etag)
+}
+
+func TestMultipartETagMatchesSource(t *testing.T) {
+	content := []byte("abcdefghij")
+	source := createTestSource(t, content)
+
+	sourceMD5, remoteETag, err := computeSourceETags(source, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	matches, err := multipartETagMatchesSource(remoteETag, sourceMD5, source, 4)
+	assert.NoError(t, err)
+	assert.True(t, matches)
+
+	// A different part size produces a different ETag
+	matches, err = multipartETagMatchesSource(remoteETag, sourceMD5, source, 5)
+	assert.NoError(t, err)
+	assert.False(t, matches)
+
+	// The configured etag doesn't match the source
+	matches, err = multipartETagMatchesSource(remoteETag, "00000000000000000000000000000000", source, 4)
+	assert.NoError(t, err)
+	assert.False(t, matches)
+
+	// Non-multipart ETags are compared as-is
+	matches, err = multipartETagMatchesSource(sourceMD5, sourceMD5, source, 4)
+	assert.NoError(t, err)
+	assert.False(t, matches)
+}
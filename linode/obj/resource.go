@@ -44,13 +44,11 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	ctx = populateLogAttributes(ctx, d)
 	tflog.Debug(ctx, "reading linode_object_storage_object")
 
-	config := meta.(*helper.ProviderMeta).Config
-	client := meta.(*helper.ProviderMeta).Client
 	cluster := d.Get("cluster").(string)
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	objKeys, diags, teardownKeysCleanUp := GetObjKeys(ctx, d, config, client, bucket, cluster, "read_only")
+	objKeys, diags, teardownKeysCleanUp := GetObjKeys(ctx, d, meta.(*helper.ProviderMeta), bucket, cluster, "read_only")
 	if diags != nil {
 		return diags
 	}
@@ -89,10 +87,20 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	d.Set("content_encoding", headOutput.ContentEncoding)
 	d.Set("content_language", headOutput.ContentLanguage)
 	d.Set("content_type", headOutput.ContentType)
-	d.Set("etag", strings.Trim(helper.StringValue(headOutput.ETag), `"`))
+	etag := strings.Trim(helper.StringValue(headOutput.ETag), `"`)
+
+	// The verified source no longer applies once the object has changed
+	if d.Get("etag").(string) != etag {
+		d.Set("source_hash", "")
+	}
+
+	d.Set("etag", etag)
 	d.Set("website_redirect", headOutput.WebsiteRedirectLocation)
 	d.Set("version_id", headOutput.VersionId)
 	d.Set("metadata", flattenObjectMetadata(headOutput.Metadata))
+	d.Set("object_lock_mode", string(headOutput.ObjectLockMode))
+	d.Set("retain_until_date", flattenRetainUntilDate(headOutput.ObjectLockRetainUntilDate))
+	d.Set("legal_hold", headOutput.ObjectLockLegalHoldStatus == s3types.ObjectLockLegalHoldStatusOn)
 
 	// Compute s3 endpoint when it's not configured by the user
 	if _, ok := d.GetOk("endpoint"); !ok {
@@ -123,11 +131,9 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	key := d.Get("key").(string)
 	acl := s3types.ObjectCannedACL(d.Get("acl").(string))
 
-	if d.HasChange("acl") {
-		config := meta.(*helper.ProviderMeta).Config
-		client := meta.(*helper.ProviderMeta).Client
+	if d.HasChanges("acl", "object_lock_mode", "retain_until_date", "legal_hold") {
 
-		objKeys, diags, teardownKeysCleanUp := GetObjKeys(ctx, d, config, client, bucket, cluster, "read_write")
+		objKeys, diags, teardownKeysCleanUp := GetObjKeys(ctx, d, meta.(*helper.ProviderMeta), bucket, cluster, "read_write")
 		if diags != nil {
 			return diags
 		}
@@ -141,20 +147,36 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 			return diag.FromErr(err)
 		}
 
-		aclPutInput := &s3.PutObjectAclInput{
-			Bucket: &bucket,
-			Key:    &key,
-			ACL:    acl,
+		if d.HasChange("acl") {
+			aclPutInput := &s3.PutObjectAclInput{
+				Bucket: &bucket,
+				Key:    &key,
+				ACL:    acl,
+			}
+			tflog.Debug(
+				ctx,
+				"detected ACL change in TF files, updating it on the cloud",
+				map[string]any{"PutObjectAclInput": aclPutInput},
+			)
+
+			_, err = s3client.PutObjectAcl(ctx, aclPutInput)
+			if err != nil {
+				return diag.Errorf("failed to put Bucket (%s) Object (%s) ACL: %s", bucket, key, err)
+			}
 		}
-		tflog.Debug(
-			ctx,
-			"detected ACL change in TF files, updating it on the cloud",
-			map[string]any{"PutObjectAclInput": aclPutInput},
-		)
 
-		_, err = s3client.PutObjectAcl(ctx, aclPutInput)
-		if err != nil {
-			return diag.Errorf("failed to put Bucket (%s) Object (%s) ACL: %s", bucket, key, err)
+		if d.HasChanges("object_lock_mode", "retain_until_date") {
+			tflog.Debug(ctx, "detected retention change in TF files, updating it on the cloud")
+			if err := putObjectRetention(ctx, d, s3client); err != nil {
+				return diag.Errorf("failed to put Bucket (%s) Object (%s) retention: %s", bucket, key, err)
+			}
+		}
+
+		if d.HasChange("legal_hold") {
+			tflog.Debug(ctx, "detected legal hold change in TF files, updating it on the cloud")
+			if err := putObjectLegalHold(ctx, d, s3client); err != nil {
+				return diag.Errorf("failed to put Bucket (%s) Object (%s) legal hold: %s", bucket, key, err)
+			}
 		}
 	}
 
@@ -165,14 +187,12 @@ func deleteResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	ctx = populateLogAttributes(ctx, d)
 	tflog.Debug(ctx, "deleting linode_object_storage_object")
 
-	config := meta.(*helper.ProviderMeta).Config
-	client := meta.(*helper.ProviderMeta).Client
 	cluster := d.Get("cluster").(string)
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 	force := d.Get("force_destroy").(bool)
 
-	objKeys, diags, teardownKeysCleanUp := GetObjKeys(ctx, d, config, client, bucket, cluster, "read_write")
+	objKeys, diags, teardownKeysCleanUp := GetObjKeys(ctx, d, meta.(*helper.ProviderMeta), bucket, cluster, "read_write")
 	if diags != nil {
 		return diags
 	}
@@ -200,8 +220,33 @@ func diffResource(
 	ctx context.Context, d *schema.ResourceDiff, meta any,
 ) error {
 	if d.HasChange("etag") {
+		oldETag, newETag := d.GetChange("etag")
+
+		if sourceHash := d.Get("source_hash").(string); sourceHash != "" &&
+			strings.EqualFold(sourceHash, newETag.(string)) {
+			tflog.Debug(ctx, "'etag' matches the previously verified source, ignoring the change")
+			return d.SetNew("etag", oldETag)
+		}
+
+		partSize := int64(d.Get("multipart_part_size").(int)) * bytesPerMiB
+
+		// Objects uploaded in multiple parts don't have an md5 based ETag,
+		// so it is compared against the multipart ETag of the source file instead.
+		matches, err := multipartETagMatchesSource(
+			oldETag.(string), newETag.(string), d.Get("source").(string), partSize,
+		)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("failed to compute multipart etag of the source: %s", err))
+		}
+
+		if matches {
+			tflog.Debug(ctx, "'etag' matches the multipart etag of the source, ignoring the change")
+			return d.SetNew("etag", oldETag)
+		}
+
 		tflog.Debug(ctx, "'etag' has been changed, computing new 'version_id'")
 		d.SetNewComputed("version_id")
+		d.SetNewComputed("source_hash")
 	}
 	return nil
 }
@@ -212,13 +257,11 @@ func diffResource(
 func putObject(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	tflog.Debug(ctx, "entered 'putObject' function")
 
-	config := meta.(*helper.ProviderMeta).Config
-	client := meta.(*helper.ProviderMeta).Client
 	cluster := d.Get("cluster").(string)
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	objKeys, diags, teardownKeysCleanUp := GetObjKeys(ctx, d, config, client, bucket, cluster, "read_write")
+	objKeys, diags, teardownKeysCleanUp := GetObjKeys(ctx, d, meta.(*helper.ProviderMeta), bucket, cluster, "read_write")
 	if diags != nil {
 		return diags
 	}
@@ -268,14 +311,119 @@ func putObject(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagn
 		tflog.Debug(ctx, fmt.Sprintf("got Metadata: %v", putInput.Metadata))
 	}
 
-	errs := putObjectWithRetries(ctx, s3client, putInput, time.Second*5)
+	// Objects without a configured retention inherit the default retention of the bucket
+	retention, err := expandObjectRetention(configuredObjectRetention(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if retention != nil {
+		putInput.ObjectLockMode = s3types.ObjectLockMode(retention.Mode)
+		putInput.ObjectLockRetainUntilDate = retention.RetainUntilDate
+	}
+
+	if d.Get("legal_hold").(bool) {
+		putInput.ObjectLockLegalHoldStatus = s3types.ObjectLockLegalHoldStatusOn
+	}
+
+	configuredETag := d.Get("etag").(string)
+
+	var errs error
+	if _, ok := d.GetOk("source"); ok {
+		errs = uploadObjectMultipart(
+			ctx,
+			s3client,
+			putInput,
+			int64(d.Get("multipart_part_size").(int))*bytesPerMiB,
+			d.Get("multipart_concurrency").(int),
+		)
+	} else {
+		errs = putObjectWithRetries(ctx, s3client, putInput, time.Second*5)
+	}
 	if errs != nil {
 		return diag.Errorf("failed to put Bucket (%s) Object (%s): %s", bucket, key, errs)
 	}
 
 	d.SetId(helper.BuildObjectStorageObjectID(d))
 
-	return readResource(ctx, d, meta)
+	diags = readResource(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Record the source when it matches the multipart ETag of the uploaded object,
+	// so that it doesn't need to be hashed again on every plan.
+	source := d.Get("source").(string)
+	partSize := int64(d.Get("multipart_part_size").(int)) * bytesPerMiB
+
+	matches, err := multipartETagMatchesSource(d.Get("etag").(string), configuredETag, source, partSize)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("failed to compute multipart etag of the source: %s", err))
+	}
+
+	if matches {
+		d.Set("source_hash", strings.ToLower(configuredETag))
+	}
+
+	return diags
+}
+
+// putObjectRetention updates the retention of the current version
+// of the object. Shortening or removing a GOVERNANCE retention
+// requires force_destroy to bypass the restriction.
+func putObjectRetention(ctx context.Context, d *schema.ResourceData, client *s3.Client) error {
+	tflog.Debug(ctx, "entered 'putObjectRetention' function")
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	retention, err := expandObjectRetention(
+		d.Get("object_lock_mode").(string), d.Get("retain_until_date").(string),
+	)
+	if err != nil {
+		return err
+	}
+
+	if retention == nil {
+		retention = &s3types.ObjectLockRetention{}
+	}
+
+	retentionPutInput := &s3.PutObjectRetentionInput{
+		Bucket:                    &bucket,
+		Key:                       &key,
+		Retention:                 retention,
+		BypassGovernanceRetention: aws.Bool(d.Get("force_destroy").(bool)),
+	}
+	if versionID, ok := d.GetOk("version_id"); ok {
+		retentionPutInput.VersionId = aws.String(versionID.(string))
+	}
+
+	tflog.Debug(ctx, "PutObjectRetentionInput", map[string]any{"PutObjectRetentionInput": retentionPutInput})
+	_, err = client.PutObjectRetention(ctx, retentionPutInput)
+	return err
+}
+
+func putObjectLegalHold(ctx context.Context, d *schema.ResourceData, client *s3.Client) error {
+	tflog.Debug(ctx, "entered 'putObjectLegalHold' function")
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	status := s3types.ObjectLockLegalHoldStatusOff
+	if d.Get("legal_hold").(bool) {
+		status = s3types.ObjectLockLegalHoldStatusOn
+	}
+
+	legalHoldPutInput := &s3.PutObjectLegalHoldInput{
+		Bucket:    &bucket,
+		Key:       &key,
+		LegalHold: &s3types.ObjectLockLegalHold{Status: status},
+	}
+	if versionID, ok := d.GetOk("version_id"); ok {
+		legalHoldPutInput.VersionId = aws.String(versionID.(string))
+	}
+
+	tflog.Debug(ctx, "PutObjectLegalHoldInput", map[string]any{"PutObjectLegalHoldInput": legalHoldPutInput})
+	_, err := client.PutObjectLegalHold(ctx, legalHoldPutInput)
+	return err
 }
 
 func deleteObject(ctx context.Context, client *s3.Client, bucket, key, version string, force bool) error {
@@ -332,6 +480,46 @@ func expandObjectMetadata(metadata map[string]any) map[string]string {
 	return metadataMap
 }
 
+// configuredObjectRetention returns the retention mode and date in the
+// configuration, ignoring the values tracked from the object.
+func configuredObjectRetention(d *schema.ResourceData) (string, string) {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return "", ""
+	}
+
+	mode, retainUntilDate := rawConfig.GetAttr("object_lock_mode"), rawConfig.GetAttr("retain_until_date")
+	if !mode.IsKnown() || mode.IsNull() || !retainUntilDate.IsKnown() || retainUntilDate.IsNull() {
+		return "", ""
+	}
+
+	return mode.AsString(), retainUntilDate.AsString()
+}
+
+func expandObjectRetention(mode, retainUntilDate string) (*s3types.ObjectLockRetention, error) {
+	if mode == "" || retainUntilDate == "" {
+		return nil, nil
+	}
+
+	retainUntil, err := time.Parse(time.RFC3339, retainUntilDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse retain_until_date: %w", err)
+	}
+
+	return &s3types.ObjectLockRetention{
+		Mode:            s3types.ObjectLockRetentionMode(mode),
+		RetainUntilDate: &retainUntil,
+	}, nil
+}
+
+func flattenRetainUntilDate(retainUntilDate *time.Time) string {
+	if retainUntilDate == nil {
+		return ""
+	}
+
+	return retainUntilDate.UTC().Format(time.RFC3339)
+}
+
 func flattenObjectMetadata(metadata map[string]string) map[string]string {
 	metadataObject := make(map[string]string, len(metadata))
 	for key, value := range metadata {
@@ -7,9 +7,13 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"os"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -23,7 +27,7 @@ import (
 var testCluster string
 
 func init() {
-	cluster, err := acceptance.GetRandomOBJCluster()
+	cluster, err := acceptance.GetOBJTestCluster()
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -86,6 +90,142 @@ func TestAccResourceObject_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceObject_multipart(t *testing.T) {
+	t.Parallel()
+
+	resName := getObjectResourceName("multipart")
+
+	// 12 MiB of content results in three parts of 5 MiB
+	content := strings.Repeat("0123456789abcdef", 12*1024*1024/16)
+	contentSource := acceptance.CreateTempFile(t, "tf-test-obj-multipart", content)
+
+	acceptance.RunTestRetry(t, 6, func(tRetry *acceptance.TRetry) {
+		bucketName := acctest.RandomWithPrefix("tf-test")
+		keyName := acctest.RandomWithPrefix("tf_test")
+
+		resource.Test(tRetry, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             checkObjectDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.Multipart(t, bucketName, testCluster, keyName, contentSource.Name()),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "key", "test_multipart"),
+						resource.TestCheckResourceAttr(resName, "multipart_part_size", "5"),
+						resource.TestCheckResourceAttr(resName, "multipart_concurrency", "2"),
+						resource.TestMatchResourceAttr(resName, "etag", regexp.MustCompile(`-3$`)),
+						resource.TestMatchResourceAttr(resName, "source_hash", regexp.MustCompile(`^[0-9a-f]{32}$`)),
+					),
+				},
+				{
+					Config:   tmpl.Multipart(t, bucketName, testCluster, keyName, contentSource.Name()),
+					PlanOnly: true,
+				},
+			},
+		})
+	})
+}
+
+func TestAccResourceObject_objectLock(t *testing.T) {
+	t.Parallel()
+
+	resName := getObjectResourceName("object_lock")
+	content := "test_object_lock"
+
+	retainUntil := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	extendedRetainUntil := retainUntil.Add(time.Hour)
+
+	acceptance.RunTestRetry(t, 6, func(tRetry *acceptance.TRetry) {
+		bucketName := acctest.RandomWithPrefix("tf-test")
+		keyName := acctest.RandomWithPrefix("tf_test")
+
+		resource.Test(tRetry, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             checkObjectDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.ObjectLock(
+						t, bucketName, testCluster, keyName, content, retainUntil.Format(time.RFC3339), true,
+					),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "object_lock_mode", "GOVERNANCE"),
+						resource.TestCheckResourceAttr(resName, "retain_until_date", retainUntil.Format(time.RFC3339)),
+						resource.TestCheckResourceAttr(resName, "legal_hold", "true"),
+					),
+				},
+				{
+					// The legal hold must be released for the object to be destroyed
+					Config: tmpl.ObjectLock(
+						t, bucketName, testCluster, keyName, content, extendedRetainUntil.Format(time.RFC3339), false,
+					),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "object_lock_mode", "GOVERNANCE"),
+						resource.TestCheckResourceAttr(resName, "retain_until_date", extendedRetainUntil.Format(time.RFC3339)),
+						resource.TestCheckResourceAttr(resName, "legal_hold", "false"),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccResourceObject_endpointOverride(t *testing.T) {
+	t.Parallel()
+
+	endpoint := acceptance.OBJTestEndpoint(t)
+	resName := getObjectResourceName("endpoint_override")
+	bucketName := acctest.RandomWithPrefix("tf-test")
+	content := "test_endpoint_override"
+
+	s3client, err := helper.S3Connection(
+		context.Background(),
+		endpoint,
+		os.Getenv("LINODE_OBJ_ACCESS_KEY"),
+		os.Getenv("LINODE_OBJ_SECRET_KEY"),
+		helper.WithS3PathStyle(true),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	headObject := func() error {
+		_, err := s3client.HeadObject(context.Background(), &s3.HeadObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String("test_endpoint_override"),
+		})
+		return err
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if _, err := s3client.CreateBucket(context.Background(), &s3.CreateBucketInput{
+				Bucket: aws.String(bucketName),
+			}); err != nil {
+				t.Fatal(err)
+			}
+		},
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if err := headObject(); err == nil {
+				return fmt.Errorf("object in bucket %s still exists", bucketName)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.EndpointOverride(t, bucketName, testCluster, endpoint, content),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "endpoint", endpoint),
+					resource.TestCheckResourceAttrSet(resName, "etag"),
+					func(s *terraform.State) error { return headObject() },
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceObject_credsConfiged(t *testing.T) {
 	t.Parallel()
 
@@ -114,6 +254,9 @@ func TestAccResourceObject_credsConfiged(t *testing.T) {
 func TestAccResourceObject_tempKeys(t *testing.T) {
 	t.Parallel()
 
+	// Delete any temporary keys still held when the test ends
+	t.Cleanup(func() { helper.ShutdownObjTempKeyBrokers(context.Background()) })
+
 	content := "test_temp_keys"
 
 	acceptance.RunTestRetry(t, 6, func(tRetry *acceptance.TRetry) {
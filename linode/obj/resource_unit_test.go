@@ -0,0 +1,32 @@
+//go:build unit
+
+package obj
+
+import (
+	"testing"
+	"time"
+
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandObjectRetention(t *testing.T) {
+	retention, err := expandObjectRetention("COMPLIANCE", "2030-01-02T03:04:05+02:00")
+	assert.NoError(t, err)
+	assert.Equal(t, s3types.ObjectLockRetentionModeCompliance, retention.Mode)
+	assert.True(t, retention.RetainUntilDate.Equal(time.Date(2030, 1, 2, 1, 4, 5, 0, time.UTC)))
+
+	retention, err = expandObjectRetention("", "")
+	assert.NoError(t, err)
+	assert.Nil(t, retention)
+
+	_, err = expandObjectRetention("GOVERNANCE", "not-a-date")
+	assert.Error(t, err)
+}
+
+func TestFlattenRetainUntilDate(t *testing.T) {
+	retainUntil := time.Date(2030, 1, 2, 3, 4, 5, 0, time.FixedZone("UTC+2", 2*60*60))
+
+	assert.Equal(t, "2030-01-02T01:04:05Z", flattenRetainUntilDate(&retainUntil))
+	assert.Equal(t, "", flattenRetainUntilDate(nil))
+}
@@ -1,8 +1,10 @@
 package obj
 
 import (
+	s3manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
@@ -118,6 +120,55 @@ var resourceSchema = map[string]*schema.Schema{
 		Description: "The version ID of this object.",
 		Computed:    true,
 	},
+	"source_hash": {
+		Type: schema.TypeString,
+		Description: "The md5 digest of the source file the multipart ETag of this object " +
+			"was last verified against.",
+		Computed: true,
+	},
+	"multipart_part_size": {
+		Type: schema.TypeInt,
+		Description: "The size in MiB of each part when uploading the source file in multiple parts. " +
+			"Source files larger than a single part are uploaded using multipart uploads.",
+		Optional:     true,
+		Default:      defaultMultipartPartSizeMiB,
+		ValidateFunc: validation.IntAtLeast(defaultMultipartPartSizeMiB),
+	},
+	"multipart_concurrency": {
+		Type:         schema.TypeInt,
+		Description:  "The number of parts to upload in parallel when uploading the source file in multiple parts.",
+		Optional:     true,
+		Default:      s3manager.DefaultUploadConcurrency,
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	"object_lock_mode": {
+		Type:         schema.TypeString,
+		Description:  "The object lock retention mode of this object (GOVERNANCE, COMPLIANCE).",
+		Optional:     true,
+		Computed:     true,
+		RequiredWith: []string{"retain_until_date"},
+		ValidateFunc: validation.StringInSlice([]string{
+			string(s3types.ObjectLockModeGovernance),
+			string(s3types.ObjectLockModeCompliance),
+		}, false),
+	},
+	"retain_until_date": {
+		Type:         schema.TypeString,
+		Description:  "The date and time in RFC3339 format until which this object is retained.",
+		Optional:     true,
+		Computed:     true,
+		RequiredWith: []string{"object_lock_mode"},
+		ValidateFunc: validation.IsRFC3339Time,
+		DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+			return helper.CompareRFC3339TimeStrings(oldValue, newValue)
+		},
+	},
+	"legal_hold": {
+		Type:        schema.TypeBool,
+		Description: "Whether a legal hold is placed on this object.",
+		Optional:    true,
+		Default:     false,
+	},
 	"website_redirect": {
 		Type:        schema.TypeString,
 		Description: "The website redirect location of this object.",
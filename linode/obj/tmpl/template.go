@@ -15,6 +15,11 @@ type TemplateData struct {
 
 	Content string
 	Source  string
+
+	RetainUntilDate string
+	LegalHold       bool
+
+	Endpoint string
 }
 
 func Basic(t *testing.T, name, cluster, keyName, content, source string) string {
@@ -39,6 +44,43 @@ func Updates(t *testing.T, name, cluster, keyName, content, source string) strin
 		})
 }
 
+func Multipart(t *testing.T, name, cluster, keyName, source string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_object_multipart", TemplateData{
+			Bucket:  objectbucket.TemplateData{Label: name, Cluster: cluster},
+			Key:     objectkey.TemplateData{Label: keyName},
+			Source:  source,
+			Cluster: cluster,
+		})
+}
+
+func ObjectLock(
+	t *testing.T, name, cluster, keyName, content, retainUntilDate string, legalHold bool,
+) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_object_object_lock", TemplateData{
+			Bucket: objectbucket.TemplateData{
+				Label:   name,
+				Cluster: cluster,
+				Key:     objectkey.TemplateData{Label: keyName},
+			},
+			Content:         content,
+			Cluster:         cluster,
+			RetainUntilDate: retainUntilDate,
+			LegalHold:       legalHold,
+		})
+}
+
+func EndpointOverride(t *testing.T, name, cluster, endpoint, content string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_object_endpoint_override", TemplateData{
+			Bucket:   objectbucket.TemplateData{Label: name, Cluster: cluster},
+			Content:  content,
+			Cluster:  cluster,
+			Endpoint: endpoint,
+		})
+}
+
 func CredsConfiged(t *testing.T, name, cluster, keyName, content string) string {
 	return acceptance.ExecuteTemplate(t,
 		"object_object_creds_configed", TemplateData{
@@ -58,3 +100,14 @@ func TempKeys(t *testing.T, name, cluster, keyName, content string) string {
 			Cluster: cluster,
 		})
 }
+
+func DataBasic(t *testing.T, name, cluster, keyName, content, source string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_object_data_basic", TemplateData{
+			Bucket:  objectbucket.TemplateData{Label: name, Cluster: cluster},
+			Key:     objectkey.TemplateData{Label: keyName},
+			Content: content,
+			Source:  source,
+			Cluster: cluster,
+		})
+}
@@ -2,8 +2,12 @@ package objbucket
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
@@ -13,3 +17,46 @@ func populateLogAttributes(ctx context.Context, d *schema.ResourceData) context.
 		"cluster": d.Get("cluster"),
 	})
 }
+
+// computeWebsiteEndpoint returns the website endpoint of the bucket,
+// which is its hostname with the cluster prefixed by "website-".
+func computeWebsiteEndpoint(bucket linodego.ObjectStorageBucket) string {
+	domain := strings.TrimPrefix(bucket.Hostname, bucket.Label+".")
+	return fmt.Sprintf("%s.website-%s", bucket.Label, domain)
+}
+
+// computeEndpoint returns the S3 endpoint of the bucket, or the
+// provider-level obj_endpoint_override if configured.
+func computeEndpoint(ctx context.Context, config *helper.Config, bucket linodego.ObjectStorageBucket) string {
+	if config.ObjEndpointOverride != "" {
+		return config.ObjEndpointOverride
+	}
+
+	return helper.ComputeS3EndpointFromBucket(ctx, bucket)
+}
+
+// staticObjKeys returns the keys of the resource,
+// falling back to the keys of the provider configuration.
+func staticObjKeys(d *schema.ResourceData, config *helper.Config) (accessKey, secretKey string) {
+	accessKey, secretKey = d.Get("access_key").(string), d.Get("secret_key").(string)
+	if accessKey == "" || secretKey == "" {
+		accessKey, secretKey = config.ObjAccessKey, config.ObjSecretKey
+	}
+
+	return accessKey, secretKey
+}
+
+// overrideS3Connection returns an S3 client for the provider-level obj_endpoint_override.
+func overrideS3Connection(ctx context.Context, d *schema.ResourceData, config *helper.Config) (*s3.Client, error) {
+	accessKey, secretKey := staticObjKeys(d, config)
+	if accessKey == "" || secretKey == "" {
+		return nil, fmt.Errorf(
+			"access_key and secret_key, or obj_access_key and obj_secret_key in the provider " +
+				"configuration, are required when obj_endpoint_override is set",
+		)
+	}
+
+	return helper.S3Connection(
+		ctx, config.ObjEndpointOverride, accessKey, secretKey, helper.WithS3PathStyle(config.ObjUsePathStyle),
+	)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
@@ -36,6 +37,30 @@ func resourceLifeCycle() *schema.Resource {
 	}
 }
 
+func resourceCORSRule() *schema.Resource {
+	return &schema.Resource{
+		Schema: resourceSchemaCORSRule,
+	}
+}
+
+func resourceWebsite() *schema.Resource {
+	return &schema.Resource{
+		Schema: resourceSchemaWebsite,
+	}
+}
+
+func resourceWebsiteRoutingRule() *schema.Resource {
+	return &schema.Resource{
+		Schema: resourceSchemaWebsiteRoutingRule,
+	}
+}
+
+func resourceDefaultRetention() *schema.Resource {
+	return &schema.Resource{
+		Schema: resourceSchemaDefaultRetention,
+	}
+}
+
 func Resource() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceSchema,
@@ -43,6 +68,7 @@ func Resource() *schema.Resource {
 		CreateContext: createResource,
 		UpdateContext: updateResource,
 		DeleteContext: deleteResource,
+		CustomizeDiff: diffResource,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -62,10 +88,19 @@ func readResource(
 		return diag.Errorf("failed to parse Linode ObjectStorageBucket id %s", d.Id())
 	}
 
-	tflog.Debug(ctx, "calling get bucket info API")
-	bucket, err := client.GetObjectStorageBucket(ctx, cluster, label)
-	if err != nil {
-		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+	var bucket *linodego.ObjectStorageBucket
+	var access *linodego.ObjectStorageBucketAccess
+
+	if config.ObjEndpointOverride != "" {
+		// Buckets of the endpoint override are unknown to the Linode API,
+		// so the access config only managed through it is kept as is.
+		tflog.Debug(ctx, "checking the bucket exists at the endpoint override")
+		exists, err := bucketExistsOverride(ctx, d, config, label)
+		if err != nil {
+			return diag.Errorf("failed to find the specified Linode ObjectStorageBucket: %s", err)
+		}
+
+		if !exists {
 			tflog.Warn(
 				ctx,
 				fmt.Sprintf(
@@ -76,28 +111,62 @@ func readResource(
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("failed to find the specified Linode ObjectStorageBucket: %s", err)
-	}
 
-	tflog.Debug(ctx, "getting bucket access info")
-	access, err := client.GetObjectStorageBucketAccess(ctx, cluster, label)
-	if err != nil {
-		return diag.Errorf("failed to find the access config for the specified Linode ObjectStorageBucket: %s", err)
+		bucket = &linodego.ObjectStorageBucket{
+			Cluster:  cluster,
+			Label:    label,
+			Hostname: d.Get("hostname").(string),
+		}
+		access = &linodego.ObjectStorageBucketAccess{
+			ACL:         linodego.ObjectStorageACL(d.Get("acl").(string)),
+			CorsEnabled: d.Get("cors_enabled").(bool),
+		}
+	} else {
+		tflog.Debug(ctx, "calling get bucket info API")
+		bucket, err = client.GetObjectStorageBucket(ctx, cluster, label)
+		if err != nil {
+			if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+				tflog.Warn(
+					ctx,
+					fmt.Sprintf(
+						"[WARN] removing Object Storage Bucket %q from state because it no longer exists",
+						d.Id(),
+					),
+				)
+				d.SetId("")
+				return nil
+			}
+			return diag.Errorf("failed to find the specified Linode ObjectStorageBucket: %s", err)
+		}
+
+		tflog.Debug(ctx, "getting bucket access info")
+		access, err = client.GetObjectStorageBucketAccess(ctx, cluster, label)
+		if err != nil {
+			return diag.Errorf("failed to find the access config for the specified Linode ObjectStorageBucket: %s", err)
+		}
 	}
 
 	// Functionality requiring direct S3 API access
-	endpoint := helper.ComputeS3EndpointFromBucket(ctx, *bucket)
+	endpoint := computeEndpoint(ctx, config, *bucket)
 
 	_, versioningPresent := d.GetOk("versioning")
 	_, lifecyclePresent := d.GetOk("lifecycle_rule")
+	_, corsPresent := d.GetOk("cors_rule")
+	_, objectLockPresent := d.GetOk("object_lock_enabled")
+	_, websitePresent := d.GetOk("website")
+
+	websiteEndpoint := ""
 
-	if versioningPresent || lifecyclePresent {
-		tflog.Debug(ctx, "versioning or lifecycle presents", map[string]any{
+	if versioningPresent || lifecyclePresent || corsPresent || objectLockPresent || websitePresent {
+		tflog.Debug(ctx, "versioning, lifecycle, cors, object lock or website presents", map[string]any{
 			"versioningPresent": versioningPresent,
 			"lifecyclePresent":  lifecyclePresent,
+			"corsPresent":       corsPresent,
+			"objectLockPresent": objectLockPresent,
+			"websitePresent":    websitePresent,
 		})
 
-		objKeys, diags, teardownKeysCleanUp := obj.GetObjKeys(ctx, d, config, client, bucket.Label, cluster, "read_only")
+		objKeys, diags, teardownKeysCleanUp := obj.GetObjKeys(ctx, d, meta.(*helper.ProviderMeta), bucket.Label, cluster, "read_only")
 		if diags != nil {
 			return diags
 		}
@@ -106,7 +175,9 @@ func readResource(
 			defer teardownKeysCleanUp()
 		}
 
-		s3Client, err := helper.S3Connection(ctx, endpoint, objKeys.AccessKey, objKeys.SecretKey)
+		s3Client, err := helper.S3Connection(
+			ctx, endpoint, objKeys.AccessKey, objKeys.SecretKey, helper.WithS3PathStyle(config.ObjUsePathStyle),
+		)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -120,6 +191,30 @@ func readResource(
 		if err := readBucketVersioning(ctx, d, s3Client); err != nil {
 			return diag.Errorf("failed to find get object storage bucket versioning: %s", err)
 		}
+
+		// The default CORS configuration applied by cors_enabled is not managed
+		// through cors_rule, so it is only read when rules are already tracked.
+		if corsPresent {
+			tflog.Debug(ctx, "getting bucket cors")
+			if err := readBucketCORS(ctx, d, s3Client); err != nil {
+				return diag.Errorf("failed to find get object storage bucket cors: %s", err)
+			}
+		}
+
+		tflog.Debug(ctx, "getting bucket object lock configuration")
+		if err := readBucketObjectLock(ctx, d, s3Client); err != nil {
+			return diag.Errorf("failed to find get object storage bucket object lock configuration: %s", err)
+		}
+
+		tflog.Debug(ctx, "getting bucket website configuration")
+		if err := readBucketWebsite(ctx, d, s3Client); err != nil {
+			return diag.Errorf("failed to find get object storage bucket website configuration: %s", err)
+		}
+
+		// The website endpoint can't be computed for buckets of the endpoint override
+		if len(d.Get("website").([]any)) > 0 && config.ObjEndpointOverride == "" {
+			websiteEndpoint = computeWebsiteEndpoint(*bucket)
+		}
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", bucket.Cluster, bucket.Label))
@@ -129,6 +224,7 @@ func readResource(
 	d.Set("acl", access.ACL)
 	d.Set("cors_enabled", access.CorsEnabled)
 	d.Set("endpoint", endpoint)
+	d.Set("website_endpoint", websiteEndpoint)
 
 	return nil
 }
@@ -152,13 +248,46 @@ func createResource(
 		CorsEnabled: &corsEnabled,
 	}
 
-	tflog.Debug(ctx, "getting object header", map[string]any{"body": createOpts})
-	bucket, err := client.CreateObjectStorageBucket(ctx, createOpts)
-	if err != nil {
-		return diag.Errorf("failed to create a Linode ObjectStorageBucket: %s", err)
+	var bucket *linodego.ObjectStorageBucket
+	var err error
+
+	config := meta.(*helper.ProviderMeta).Config
+
+	if config.ObjEndpointOverride != "" {
+		if len(d.Get("cert").([]any)) > 0 {
+			return diag.Errorf("cert can't be managed when obj_endpoint_override is set")
+		}
+
+		if err := createBucketOverride(ctx, d, config); err != nil {
+			return diag.Errorf("failed to create a Linode ObjectStorageBucket: %s", err)
+		}
+
+		bucket = &linodego.ObjectStorageBucket{Cluster: cluster, Label: label}
+	} else if d.Get("object_lock_enabled").(bool) {
+		// Object lock can only be enabled when a bucket is created,
+		// which the Linode API doesn't support, so such buckets are created through S3.
+		if err := createBucketWithObjectLock(ctx, d, meta); err != nil {
+			return diag.Errorf("failed to create a Linode ObjectStorageBucket with object lock: %s", err)
+		}
+
+		tflog.Debug(ctx, "client.GetObjectStorageBucket(...)")
+		bucket, err = client.GetObjectStorageBucket(ctx, cluster, label)
+		if err != nil {
+			return diag.Errorf("failed to get the created Linode ObjectStorageBucket: %s", err)
+		}
+
+		if err := updateBucketAccess(ctx, d, client); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		tflog.Debug(ctx, "getting object header", map[string]any{"body": createOpts})
+		bucket, err = client.CreateObjectStorageBucket(ctx, createOpts)
+		if err != nil {
+			return diag.Errorf("failed to create a Linode ObjectStorageBucket: %s", err)
+		}
 	}
 
-	d.Set("endpoint", helper.ComputeS3EndpointFromBucket(ctx, *bucket))
+	d.Set("endpoint", computeEndpoint(ctx, config, *bucket))
 	d.SetId(fmt.Sprintf("%s:%s", bucket.Cluster, bucket.Label))
 
 	return updateResource(ctx, d, meta)
@@ -170,15 +299,28 @@ func updateResource(
 	ctx = populateLogAttributes(ctx, d)
 	tflog.Debug(ctx, "updating linode_object_storage_bucket")
 	client := meta.(*helper.ProviderMeta).Client
+	config := meta.(*helper.ProviderMeta).Config
 
-	if d.HasChanges("acl", "cors_enabled") {
+	if config.ObjEndpointOverride != "" {
+		// Only the ACL is managed through S3, cors_enabled and cert are Linode API features
+		if d.HasChange("acl") {
+			tflog.Debug(ctx, "'acl' changes detected, will update bucket ACL at the endpoint override")
+			if err := updateBucketACLOverride(ctx, d, config); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if len(d.Get("cert").([]any)) > 0 {
+			return diag.Errorf("cert can't be managed when obj_endpoint_override is set")
+		}
+	} else if d.HasChanges("acl", "cors_enabled") {
 		tflog.Debug(ctx, "'acl' changes detected, will update bucket access")
 		if err := updateBucketAccess(ctx, d, client); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	if d.HasChange("cert") {
+	if config.ObjEndpointOverride == "" && d.HasChange("cert") {
 		tflog.Debug(ctx, "'cert' changes detected, will update bucket certificate")
 		if err := updateBucketCert(ctx, d, client); err != nil {
 			return diag.FromErr(err)
@@ -187,18 +329,26 @@ func updateResource(
 
 	versioningChanged := d.HasChange("versioning")
 	lifecycleChanged := d.HasChange("lifecycle_rule")
-
-	if versioningChanged || lifecycleChanged {
-		tflog.Debug(ctx, "versioning or lifecycle change detected", map[string]any{
+	// Toggling cors_enabled replaces the CORS configuration of the bucket,
+	// so the custom rules are put again afterwards.
+	corsChanged := d.HasChange("cors_rule") ||
+		(d.HasChange("cors_enabled") && len(d.Get("cors_rule").([]any)) > 0)
+	objectLockChanged := d.HasChanges("object_lock_enabled", "default_retention")
+	websiteChanged := d.HasChange("website")
+
+	if versioningChanged || lifecycleChanged || corsChanged || objectLockChanged || websiteChanged {
+		tflog.Debug(ctx, "versioning, lifecycle, cors, object lock or website change detected", map[string]any{
 			"versioningChanged": versioningChanged,
 			"lifecycleChanged":  lifecycleChanged,
+			"corsChanged":       corsChanged,
+			"objectLockChanged": objectLockChanged,
+			"websiteChanged":    websiteChanged,
 		})
 
-		config := meta.(*helper.ProviderMeta).Config
 		cluster := d.Get("cluster").(string)
 		bucket := d.Get("label").(string)
 
-		objKeys, diags, teardownKeysCleanUp := obj.GetObjKeys(ctx, d, config, client, bucket, cluster, "read_write")
+		objKeys, diags, teardownKeysCleanUp := obj.GetObjKeys(ctx, d, meta.(*helper.ProviderMeta), bucket, cluster, "read_write")
 		if diags != nil {
 			return diags
 		}
@@ -226,6 +376,35 @@ func updateResource(
 				return diag.FromErr(err)
 			}
 		}
+
+		if corsChanged {
+			tflog.Debug(ctx, "updating bucket cors configuration")
+			if err := updateBucketCORS(ctx, d, s3client); err != nil {
+				return diag.FromErr(err)
+			}
+
+			// Deleting the last custom rule also deletes the default configuration of cors_enabled
+			if len(d.Get("cors_rule").([]any)) == 0 && d.Get("cors_enabled").(bool) && config.ObjEndpointOverride == "" {
+				tflog.Debug(ctx, "restoring the default bucket cors configuration")
+				if err := restoreDefaultBucketCORS(ctx, d, client); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+
+		if objectLockChanged {
+			tflog.Debug(ctx, "updating bucket object lock configuration")
+			if err := updateBucketObjectLock(ctx, d, s3client); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if websiteChanged {
+			tflog.Debug(ctx, "updating bucket website configuration")
+			if err := updateBucketWebsite(ctx, d, s3client); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	return readResource(ctx, d, meta)
@@ -243,6 +422,14 @@ func deleteResource(
 		return diag.Errorf("Error parsing Linode ObjectStorageBucket id %s", d.Id())
 	}
 
+	if config := meta.(*helper.ProviderMeta).Config; config.ObjEndpointOverride != "" {
+		tflog.Debug(ctx, "deleting bucket at the endpoint override")
+		if err := deleteBucketOverride(ctx, d, config, label); err != nil {
+			return diag.Errorf("Error deleting Linode ObjectStorageBucket %s: %s", d.Id(), err)
+		}
+		return nil
+	}
+
 	tflog.Debug(ctx, "calling bucket deleting API")
 	err = client.DeleteObjectStorageBucket(ctx, cluster, label)
 	if err != nil {
@@ -251,6 +438,27 @@ func deleteResource(
 	return nil
 }
 
+func diffResource(
+	ctx context.Context, d *schema.ResourceDiff, meta any,
+) error {
+	if !d.Get("object_lock_enabled").(bool) {
+		return nil
+	}
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	// Object lock can't be enabled on a bucket without versioning
+	versioning := rawConfig.GetAttr("versioning")
+	if versioning.IsKnown() && !versioning.IsNull() && versioning.False() {
+		return fmt.Errorf("versioning must be enabled when object_lock_enabled is set")
+	}
+
+	return nil
+}
+
 func readBucketVersioning(ctx context.Context, d *schema.ResourceData, client *s3.Client) error {
 	tflog.Debug(ctx, "entering readBucketVersioning")
 	label := d.Get("label").(string)
@@ -304,6 +512,89 @@ func readBucketLifecycle(ctx context.Context, d *schema.ResourceData, client *s3
 	return nil
 }
 
+func readBucketCORS(ctx context.Context, d *schema.ResourceData, client *s3.Client) error {
+	tflog.Debug(ctx, "entering readBucketCORS")
+	label := d.Get("label").(string)
+
+	tflog.Debug(ctx, "getting bucket cors info from the API")
+	corsOutput, err := client.GetBucketCors(
+		ctx,
+		&s3.GetBucketCorsInput{Bucket: &label},
+	)
+	// A "NoSuchCORSConfiguration" error means that no rules are applied
+	if err != nil {
+		var ae smithy.APIError
+		if ok := errors.As(err, &ae); !ok || ae.ErrorCode() != "NoSuchCORSConfiguration" {
+			return fmt.Errorf("failed to get cors for bucket id %s: %w", d.Id(), err)
+		}
+	}
+
+	var rules []s3types.CORSRule
+	if corsOutput != nil {
+		rules = corsOutput.CORSRules
+	}
+
+	d.Set("cors_rule", flattenCORSRules(ctx, rules))
+
+	return nil
+}
+
+func readBucketObjectLock(ctx context.Context, d *schema.ResourceData, client *s3.Client) error {
+	tflog.Debug(ctx, "entering readBucketObjectLock")
+	label := d.Get("label").(string)
+
+	tflog.Debug(ctx, "getting bucket object lock info from the API")
+	objectLockOutput, err := client.GetObjectLockConfiguration(
+		ctx,
+		&s3.GetObjectLockConfigurationInput{Bucket: &label},
+	)
+	// An "ObjectLockConfigurationNotFoundError" error means that object lock isn't enabled
+	if err != nil {
+		var ae smithy.APIError
+		if ok := errors.As(err, &ae); !ok || ae.ErrorCode() != "ObjectLockConfigurationNotFoundError" {
+			return fmt.Errorf("failed to get object lock configuration for bucket id %s: %w", d.Id(), err)
+		}
+	}
+
+	var lockConfig *s3types.ObjectLockConfiguration
+	if objectLockOutput != nil {
+		lockConfig = objectLockOutput.ObjectLockConfiguration
+	}
+
+	if lockConfig == nil {
+		d.Set("object_lock_enabled", false)
+		d.Set("default_retention", nil)
+		return nil
+	}
+
+	d.Set("object_lock_enabled", lockConfig.ObjectLockEnabled == s3types.ObjectLockEnabledEnabled)
+	d.Set("default_retention", flattenDefaultRetention(ctx, lockConfig.Rule))
+
+	return nil
+}
+
+func readBucketWebsite(ctx context.Context, d *schema.ResourceData, client *s3.Client) error {
+	tflog.Debug(ctx, "entering readBucketWebsite")
+	label := d.Get("label").(string)
+
+	tflog.Debug(ctx, "getting bucket website info from the API")
+	websiteOutput, err := client.GetBucketWebsite(
+		ctx,
+		&s3.GetBucketWebsiteInput{Bucket: &label},
+	)
+	// A "NoSuchWebsiteConfiguration" error means that the bucket isn't a website
+	if err != nil {
+		var ae smithy.APIError
+		if ok := errors.As(err, &ae); !ok || ae.ErrorCode() != "NoSuchWebsiteConfiguration" {
+			return fmt.Errorf("failed to get website for bucket id %s: %w", d.Id(), err)
+		}
+	}
+
+	d.Set("website", flattenWebsite(ctx, websiteOutput))
+
+	return nil
+}
+
 func updateBucketVersioning(
 	ctx context.Context,
 	d *schema.ResourceData,
@@ -372,6 +663,244 @@ func updateBucketLifecycle(
 	return err
 }
 
+func updateBucketCORS(
+	ctx context.Context,
+	d *schema.ResourceData,
+	client *s3.Client,
+) error {
+	tflog.Debug(ctx, "entering updateBucketCORS")
+	bucket := d.Get("label").(string)
+
+	rules, err := expandCORSRules(ctx, d.Get("cors_rule").([]any))
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "got expanded cors rules", map[string]any{
+		"rules": rules,
+	})
+	if len(rules) > 0 {
+		tflog.Debug(ctx, "there is at least one rule, calling the put endpoint")
+		_, err = client.PutBucketCors(
+			ctx,
+			&s3.PutBucketCorsInput{
+				Bucket: &bucket,
+				CORSConfiguration: &s3types.CORSConfiguration{
+					CORSRules: rules,
+				},
+			},
+		)
+	} else {
+		tflog.Debug(ctx, "there isn't a rule presents, calling the delete endpoint")
+		_, err = client.DeleteBucketCors(
+			ctx,
+			&s3.DeleteBucketCorsInput{Bucket: &bucket},
+		)
+	}
+
+	return err
+}
+
+// createBucketWithObjectLock creates the bucket through the S3 API with object lock enabled.
+// Temporary keys can't be used since they are scoped to existing buckets.
+func createBucketWithObjectLock(ctx context.Context, d *schema.ResourceData, meta any) error {
+	tflog.Debug(ctx, "entering createBucketWithObjectLock")
+	providerMeta := meta.(*helper.ProviderMeta)
+
+	cluster := d.Get("cluster").(string)
+	bucket := d.Get("label").(string)
+
+	accessKey, secretKey := staticObjKeys(d, providerMeta.Config)
+	if accessKey == "" || secretKey == "" {
+		return fmt.Errorf(
+			"access_key and secret_key, or obj_access_key and obj_secret_key in the provider " +
+				"configuration, are required to create a bucket with object lock enabled",
+		)
+	}
+
+	tflog.Debug(ctx, "client.GetObjectStorageCluster(...)")
+	objCluster, err := providerMeta.Client.GetObjectStorageCluster(ctx, cluster)
+	if err != nil {
+		return fmt.Errorf("failed to get object storage cluster %s: %w", cluster, err)
+	}
+
+	s3client, err := helper.S3Connection(
+		ctx, objCluster.Domain, accessKey, secretKey, helper.WithS3PathStyle(providerMeta.Config.ObjUsePathStyle),
+	)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "creating bucket with object lock enabled")
+	if _, err := s3client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket:                     &bucket,
+		ObjectLockEnabledForBucket: aws.Bool(true),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// createBucketOverride creates the bucket at the provider-level obj_endpoint_override,
+// applying the ACL and object lock which can only be set through the S3 API there.
+func createBucketOverride(ctx context.Context, d *schema.ResourceData, config *helper.Config) error {
+	tflog.Debug(ctx, "entering createBucketOverride")
+	bucket := d.Get("label").(string)
+
+	s3client, err := overrideS3Connection(ctx, d, config)
+	if err != nil {
+		return err
+	}
+
+	input := &s3.CreateBucketInput{
+		Bucket: &bucket,
+		ACL:    s3types.BucketCannedACL(d.Get("acl").(string)),
+	}
+	if d.Get("object_lock_enabled").(bool) {
+		input.ObjectLockEnabledForBucket = aws.Bool(true)
+	}
+
+	tflog.Debug(ctx, "creating bucket at the endpoint override", map[string]any{"input": input})
+	_, err = s3client.CreateBucket(ctx, input)
+	return err
+}
+
+// bucketExistsOverride checks whether the bucket exists at the provider-level obj_endpoint_override.
+func bucketExistsOverride(
+	ctx context.Context, d *schema.ResourceData, config *helper.Config, bucket string,
+) (bool, error) {
+	tflog.Debug(ctx, "entering bucketExistsOverride")
+
+	s3client, err := overrideS3Connection(ctx, d, config)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := s3client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: &bucket}); err != nil {
+		var notFound *s3types.NotFound
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func updateBucketACLOverride(ctx context.Context, d *schema.ResourceData, config *helper.Config) error {
+	tflog.Debug(ctx, "entering updateBucketACLOverride")
+	bucket := d.Get("label").(string)
+
+	s3client, err := overrideS3Connection(ctx, d, config)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s3client.PutBucketAcl(ctx, &s3.PutBucketAclInput{
+		Bucket: &bucket,
+		ACL:    s3types.BucketCannedACL(d.Get("acl").(string)),
+	}); err != nil {
+		return fmt.Errorf("failed to update bucket ACL: %w", err)
+	}
+
+	return nil
+}
+
+func deleteBucketOverride(ctx context.Context, d *schema.ResourceData, config *helper.Config, bucket string) error {
+	tflog.Debug(ctx, "entering deleteBucketOverride")
+
+	s3client, err := overrideS3Connection(ctx, d, config)
+	if err != nil {
+		return err
+	}
+
+	_, err = s3client.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: &bucket})
+	return err
+}
+
+func updateBucketObjectLock(
+	ctx context.Context,
+	d *schema.ResourceData,
+	client *s3.Client,
+) error {
+	tflog.Debug(ctx, "entering updateBucketObjectLock")
+	bucket := d.Get("label").(string)
+
+	if !d.Get("object_lock_enabled").(bool) {
+		tflog.Debug(ctx, "object lock isn't enabled, skipping object lock configuration")
+		return nil
+	}
+
+	// Object lock requires versioning, so make sure it is enabled
+	// before the object lock configuration is put.
+	if d.HasChange("object_lock_enabled") {
+		tflog.Debug(ctx, "enabling bucket versioning for object lock")
+		if _, err := client.PutBucketVersioning(ctx, &s3.PutBucketVersioningInput{
+			Bucket: &bucket,
+			VersioningConfiguration: &s3types.VersioningConfiguration{
+				Status: s3types.BucketVersioningStatusEnabled,
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to enable versioning for object lock: %w", err)
+		}
+	}
+
+	rule, err := expandDefaultRetention(ctx, d.Get("default_retention").([]any))
+	if err != nil {
+		return err
+	}
+
+	inputObjectLockConfig := &s3.PutObjectLockConfigurationInput{
+		Bucket: &bucket,
+		ObjectLockConfiguration: &s3types.ObjectLockConfiguration{
+			ObjectLockEnabled: s3types.ObjectLockEnabledEnabled,
+			Rule:              rule,
+		},
+	}
+	tflog.Debug(ctx, "making put object lock configuration call to the API", map[string]any{
+		"input": inputObjectLockConfig,
+	})
+	if _, err := client.PutObjectLockConfiguration(ctx, inputObjectLockConfig); err != nil {
+		return fmt.Errorf("failed to put object lock configuration: %w", err)
+	}
+
+	return nil
+}
+
+func updateBucketWebsite(
+	ctx context.Context,
+	d *schema.ResourceData,
+	client *s3.Client,
+) error {
+	tflog.Debug(ctx, "entering updateBucketWebsite")
+	bucket := d.Get("label").(string)
+
+	websiteConfig := expandWebsite(ctx, d.Get("website").([]any))
+
+	var err error
+	if websiteConfig != nil {
+		tflog.Debug(ctx, "website is configured, calling the put endpoint", map[string]any{
+			"config": websiteConfig,
+		})
+		_, err = client.PutBucketWebsite(
+			ctx,
+			&s3.PutBucketWebsiteInput{
+				Bucket:               &bucket,
+				WebsiteConfiguration: websiteConfig,
+			},
+		)
+	} else {
+		tflog.Debug(ctx, "website isn't configured, calling the delete endpoint")
+		_, err = client.DeleteBucketWebsite(
+			ctx,
+			&s3.DeleteBucketWebsiteInput{Bucket: &bucket},
+		)
+	}
+
+	return err
+}
+
 func updateBucketAccess(
 	ctx context.Context, d *schema.ResourceData, client linodego.Client,
 ) error {
@@ -396,6 +925,25 @@ func updateBucketAccess(
 	return nil
 }
 
+// restoreDefaultBucketCORS applies the default CORS configuration of cors_enabled again.
+func restoreDefaultBucketCORS(
+	ctx context.Context, d *schema.ResourceData, client linodego.Client,
+) error {
+	tflog.Debug(ctx, "entering restoreDefaultBucketCORS")
+	cluster := d.Get("cluster").(string)
+	label := d.Get("label").(string)
+
+	corsEnabled := true
+	updateOpts := linodego.ObjectStorageBucketUpdateAccessOptions{CorsEnabled: &corsEnabled}
+
+	tflog.Debug(ctx, "updating bucket access", map[string]any{"updateOpts": updateOpts})
+	if err := client.UpdateObjectStorageBucketAccess(ctx, cluster, label, updateOpts); err != nil {
+		return fmt.Errorf("failed to restore the default bucket cors configuration: %s", err)
+	}
+
+	return nil
+}
+
 func updateBucketCert(
 	ctx context.Context, d *schema.ResourceData, client linodego.Client,
 ) error {
@@ -582,6 +1130,222 @@ func expandLifecycleRules(ctx context.Context, ruleSpecs []any) ([]s3types.Lifec
 	return rules, nil
 }
 
+func flattenCORSRules(ctx context.Context, rules []s3types.CORSRule) []map[string]any {
+	tflog.Debug(ctx, "entering flattenCORSRules")
+	result := make([]map[string]any, len(rules))
+
+	for i, rule := range rules {
+		ruleMap := map[string]any{
+			"allowed_origins": rule.AllowedOrigins,
+			"allowed_methods": rule.AllowedMethods,
+			"allowed_headers": rule.AllowedHeaders,
+			"expose_headers":  rule.ExposeHeaders,
+		}
+
+		if id := rule.ID; id != nil {
+			ruleMap["id"] = *id
+		}
+
+		if maxAge := rule.MaxAgeSeconds; maxAge != nil {
+			ruleMap["max_age_seconds"] = int(*maxAge)
+		}
+
+		tflog.Debug(ctx, "a cors rule has been flattened", ruleMap)
+		result[i] = ruleMap
+	}
+
+	return result
+}
+
+func expandCORSRules(ctx context.Context, ruleSpecs []any) ([]s3types.CORSRule, error) {
+	tflog.Debug(ctx, "entering expandCORSRules")
+
+	rules := make([]s3types.CORSRule, len(ruleSpecs))
+	for i, ruleSpec := range ruleSpecs {
+		ruleSpec := ruleSpec.(map[string]any)
+		rule := s3types.CORSRule{
+			AllowedOrigins: helper.ExpandStringList(ruleSpec["allowed_origins"].([]any)),
+			AllowedMethods: helper.ExpandStringList(ruleSpec["allowed_methods"].([]any)),
+			AllowedHeaders: helper.ExpandStringList(ruleSpec["allowed_headers"].([]any)),
+			ExposeHeaders:  helper.ExpandStringList(ruleSpec["expose_headers"].([]any)),
+		}
+
+		if id, ok := ruleSpec["id"].(string); ok && id != "" {
+			rule.ID = &id
+		}
+
+		if maxAge, ok := ruleSpec["max_age_seconds"].(int); ok && maxAge > 0 {
+			int32MaxAge, err := helper.SafeIntToInt32(maxAge)
+			if err != nil {
+				return nil, err
+			}
+			rule.MaxAgeSeconds = &int32MaxAge
+		}
+
+		tflog.Debug(ctx, "a cors rule has been expanded", map[string]any{"rule": rule})
+		rules[i] = rule
+	}
+
+	return rules, nil
+}
+
+func flattenDefaultRetention(ctx context.Context, rule *s3types.ObjectLockRule) []map[string]any {
+	tflog.Debug(ctx, "entering flattenDefaultRetention")
+
+	if rule == nil || rule.DefaultRetention == nil {
+		return nil
+	}
+
+	retention := map[string]any{
+		"mode": string(rule.DefaultRetention.Mode),
+	}
+
+	if days := rule.DefaultRetention.Days; days != nil && *days > 0 {
+		retention["days"] = int(*days)
+	}
+
+	if years := rule.DefaultRetention.Years; years != nil && *years > 0 {
+		retention["years"] = int(*years)
+	}
+
+	return []map[string]any{retention}
+}
+
+func expandDefaultRetention(ctx context.Context, retentionSpecs []any) (*s3types.ObjectLockRule, error) {
+	tflog.Debug(ctx, "entering expandDefaultRetention")
+
+	if len(retentionSpecs) == 0 || retentionSpecs[0] == nil {
+		return nil, nil
+	}
+
+	retentionSpec := retentionSpecs[0].(map[string]any)
+	retention := &s3types.DefaultRetention{
+		Mode: s3types.ObjectLockRetentionMode(retentionSpec["mode"].(string)),
+	}
+
+	if days, ok := retentionSpec["days"].(int); ok && days > 0 {
+		int32Days, err := helper.SafeIntToInt32(days)
+		if err != nil {
+			return nil, err
+		}
+		retention.Days = &int32Days
+	}
+
+	if years, ok := retentionSpec["years"].(int); ok && years > 0 {
+		int32Years, err := helper.SafeIntToInt32(years)
+		if err != nil {
+			return nil, err
+		}
+		retention.Years = &int32Years
+	}
+
+	return &s3types.ObjectLockRule{DefaultRetention: retention}, nil
+}
+
+func flattenWebsite(ctx context.Context, website *s3.GetBucketWebsiteOutput) []map[string]any {
+	tflog.Debug(ctx, "entering flattenWebsite")
+
+	if website == nil || website.IndexDocument == nil {
+		return nil
+	}
+
+	result := map[string]any{
+		"index_document": helper.StringValue(website.IndexDocument.Suffix),
+	}
+
+	if website.ErrorDocument != nil {
+		result["error_document"] = helper.StringValue(website.ErrorDocument.Key)
+	}
+
+	routingRules := make([]map[string]any, len(website.RoutingRules))
+	for i, rule := range website.RoutingRules {
+		ruleMap := make(map[string]any)
+
+		if rule.Condition != nil {
+			ruleMap["condition"] = []map[string]any{{
+				"key_prefix_equals":               helper.StringValue(rule.Condition.KeyPrefixEquals),
+				"http_error_code_returned_equals": helper.StringValue(rule.Condition.HttpErrorCodeReturnedEquals),
+			}}
+		}
+
+		if rule.Redirect != nil {
+			ruleMap["redirect"] = []map[string]any{{
+				"host_name":               helper.StringValue(rule.Redirect.HostName),
+				"http_redirect_code":      helper.StringValue(rule.Redirect.HttpRedirectCode),
+				"protocol":                string(rule.Redirect.Protocol),
+				"replace_key_prefix_with": helper.StringValue(rule.Redirect.ReplaceKeyPrefixWith),
+				"replace_key_with":        helper.StringValue(rule.Redirect.ReplaceKeyWith),
+			}}
+		}
+
+		routingRules[i] = ruleMap
+	}
+	result["routing_rule"] = routingRules
+
+	tflog.Debug(ctx, "website configuration has been flattened", result)
+
+	return []map[string]any{result}
+}
+
+func expandWebsite(ctx context.Context, websiteSpecs []any) *s3types.WebsiteConfiguration {
+	tflog.Debug(ctx, "entering expandWebsite")
+
+	if len(websiteSpecs) == 0 || websiteSpecs[0] == nil {
+		return nil
+	}
+
+	nilOrValue := func(v any) *string {
+		s, ok := v.(string)
+		if !ok || s == "" {
+			return nil
+		}
+		return &s
+	}
+
+	websiteSpec := websiteSpecs[0].(map[string]any)
+	result := &s3types.WebsiteConfiguration{
+		IndexDocument: &s3types.IndexDocument{
+			Suffix: nilOrValue(websiteSpec["index_document"]),
+		},
+	}
+
+	if errorDocument := nilOrValue(websiteSpec["error_document"]); errorDocument != nil {
+		result.ErrorDocument = &s3types.ErrorDocument{Key: errorDocument}
+	}
+
+	ruleSpecs, _ := websiteSpec["routing_rule"].([]any)
+	for _, ruleSpec := range ruleSpecs {
+		ruleSpec := ruleSpec.(map[string]any)
+		rule := s3types.RoutingRule{}
+
+		if conditions, ok := ruleSpec["condition"].([]any); ok && len(conditions) > 0 && conditions[0] != nil {
+			condition := conditions[0].(map[string]any)
+			rule.Condition = &s3types.Condition{
+				KeyPrefixEquals:             nilOrValue(condition["key_prefix_equals"]),
+				HttpErrorCodeReturnedEquals: nilOrValue(condition["http_error_code_returned_equals"]),
+			}
+		}
+
+		rule.Redirect = &s3types.Redirect{}
+		if redirects, ok := ruleSpec["redirect"].([]any); ok && len(redirects) > 0 && redirects[0] != nil {
+			redirect := redirects[0].(map[string]any)
+			rule.Redirect = &s3types.Redirect{
+				HostName:             nilOrValue(redirect["host_name"]),
+				HttpRedirectCode:     nilOrValue(redirect["http_redirect_code"]),
+				Protocol:             s3types.Protocol(redirect["protocol"].(string)),
+				ReplaceKeyPrefixWith: nilOrValue(redirect["replace_key_prefix_with"]),
+				ReplaceKeyWith:       nilOrValue(redirect["replace_key_with"]),
+			}
+		}
+
+		result.RoutingRules = append(result.RoutingRules, rule)
+	}
+
+	tflog.Debug(ctx, "website configuration has been expanded", map[string]any{"config": result})
+
+	return result
+}
+
 // matchRulesWithSchema is for keeping the order of existing rules in the
 // TF states and append any addition rules received
 func matchRulesWithSchema(
@@ -41,7 +41,7 @@ const (
 var testCluster string
 
 func init() {
-	cluster, err := acceptance.GetRandomOBJCluster()
+	cluster, err := acceptance.GetOBJTestCluster()
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -195,6 +195,59 @@ func TestAccResourceBucket_basic_smoke(t *testing.T) {
 	})
 }
 
+func TestAccResourceBucket_endpointOverride(t *testing.T) {
+	t.Parallel()
+
+	endpoint := acceptance.OBJTestEndpoint(t)
+	resName := "linode_object_storage_bucket.foobar"
+	bucketName := acctest.RandomWithPrefix("tf-test")
+
+	s3client, err := helper.S3Connection(
+		context.Background(),
+		endpoint,
+		os.Getenv(objAccessKeyEnvVar),
+		os.Getenv(objSecretKeyEnvVar),
+		helper.WithS3PathStyle(true),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	headBucket := func() error {
+		_, err := s3client.HeadBucket(context.Background(), &s3.HeadBucketInput{
+			Bucket: aws.String(bucketName),
+		})
+		return err
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if err := headBucket(); err == nil {
+				return fmt.Errorf("bucket %s still exists", bucketName)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.EndpointOverride(t, bucketName, testCluster, endpoint, "public-read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "endpoint", endpoint),
+					resource.TestCheckResourceAttr(resName, "acl", "public-read"),
+					resource.TestCheckResourceAttr(resName, "versioning", "true"),
+					func(s *terraform.State) error { return headBucket() },
+				),
+			},
+			{
+				Config: tmpl.EndpointOverride(t, bucketName, testCluster, endpoint, "private"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "acl", "private"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceBucket_access(t *testing.T) {
 	t.Parallel()
 
@@ -249,6 +302,7 @@ func TestAccResourceBucket_versioning(t *testing.T) {
 						checkBucketExists,
 						resource.TestCheckResourceAttr(resName, "label", objectStorageBucketName),
 						resource.TestCheckResourceAttr(resName, "versioning", "true"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.#", "0"),
 					),
 				},
 				{
@@ -318,6 +372,151 @@ func TestAccResourceBucket_lifecycle(t *testing.T) {
 	})
 }
 
+func TestAccResourceBucket_cors(t *testing.T) {
+	t.Parallel()
+
+	acceptance.RunTestRetry(t, 5, func(retryT *acceptance.TRetry) {
+		resName := "linode_object_storage_bucket.foobar"
+		objectStorageBucketName := acctest.RandomWithPrefix("tf-test")
+		objectStorageKeyName := acctest.RandomWithPrefix("tf-test")
+
+		resource.Test(retryT, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             checkBucketDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.CORS(t, objectStorageBucketName, testCluster, objectStorageKeyName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "label", objectStorageBucketName),
+						resource.TestCheckResourceAttr(resName, "cors_rule.#", "1"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.id", "test-rule"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.allowed_origins.#", "1"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.allowed_origins.0", "https://example.com"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.allowed_methods.#", "2"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.allowed_headers.0", "*"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.expose_headers.0", "ETag"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.max_age_seconds", "3600"),
+					),
+				},
+				{
+					Config: tmpl.CORSUpdates(t, objectStorageBucketName, testCluster, objectStorageKeyName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "label", objectStorageBucketName),
+						resource.TestCheckResourceAttr(resName, "cors_rule.#", "1"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.id", "test-rule-update"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.allowed_origins.#", "2"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.allowed_methods.#", "1"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.allowed_methods.0", "GET"),
+					),
+				},
+				{
+					Config: tmpl.LifeCycleRemoved(t, objectStorageBucketName, testCluster, objectStorageKeyName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "label", objectStorageBucketName),
+						resource.TestCheckResourceAttr(resName, "cors_rule.#", "0"),
+						resource.TestCheckResourceAttr(resName, "cors_enabled", "true"),
+						checkBucketHasCORS(resName),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccResourceBucket_objectLock(t *testing.T) {
+	t.Parallel()
+
+	acceptance.RunTestRetry(t, 5, func(retryT *acceptance.TRetry) {
+		resName := "linode_object_storage_bucket.foobar"
+		objectStorageBucketName := acctest.RandomWithPrefix("tf-test")
+		objectStorageKeyName := acctest.RandomWithPrefix("tf-test")
+
+		resource.Test(retryT, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             checkBucketDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.ObjectLock(t, objectStorageBucketName, testCluster, objectStorageKeyName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "label", objectStorageBucketName),
+						resource.TestCheckResourceAttr(resName, "object_lock_enabled", "true"),
+						resource.TestCheckResourceAttr(resName, "versioning", "true"),
+						resource.TestCheckResourceAttr(resName, "default_retention.#", "1"),
+						resource.TestCheckResourceAttr(resName, "default_retention.0.mode", "GOVERNANCE"),
+						resource.TestCheckResourceAttr(resName, "default_retention.0.days", "1"),
+					),
+				},
+				{
+					Config: tmpl.ObjectLockUpdates(t, objectStorageBucketName, testCluster, objectStorageKeyName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "object_lock_enabled", "true"),
+						resource.TestCheckResourceAttr(resName, "default_retention.#", "1"),
+						resource.TestCheckResourceAttr(resName, "default_retention.0.mode", "COMPLIANCE"),
+						resource.TestCheckResourceAttr(resName, "default_retention.0.years", "1"),
+					),
+				},
+				{
+					Config: tmpl.ObjectLockNoRetention(t, objectStorageBucketName, testCluster, objectStorageKeyName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "object_lock_enabled", "true"),
+						resource.TestCheckResourceAttr(resName, "default_retention.#", "0"),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccResourceBucket_website(t *testing.T) {
+	t.Parallel()
+
+	acceptance.RunTestRetry(t, 5, func(retryT *acceptance.TRetry) {
+		resName := "linode_object_storage_bucket.foobar"
+		objectStorageBucketName := acctest.RandomWithPrefix("tf-test")
+		objectStorageKeyName := acctest.RandomWithPrefix("tf-test")
+
+		resource.Test(retryT, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             checkBucketDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.Website(t, objectStorageBucketName, testCluster, objectStorageKeyName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "label", objectStorageBucketName),
+						resource.TestCheckResourceAttr(resName, "website.#", "1"),
+						resource.TestCheckResourceAttr(resName, "website.0.index_document", "index.html"),
+						resource.TestCheckResourceAttr(resName, "website.0.error_document", "404.html"),
+						resource.TestCheckResourceAttr(resName, "website.0.routing_rule.#", "1"),
+						resource.TestCheckResourceAttr(resName, "website.0.routing_rule.0.condition.0.key_prefix_equals", "docs/"),
+						resource.TestCheckResourceAttr(resName, "website.0.routing_rule.0.redirect.0.replace_key_prefix_with", "documents/"),
+						resource.TestMatchResourceAttr(resName, "website_endpoint", regexp.MustCompile(
+							fmt.Sprintf(`^%s\.website-`, objectStorageBucketName),
+						)),
+					),
+				},
+				{
+					Config: tmpl.WebsiteUpdates(t, objectStorageBucketName, testCluster, objectStorageKeyName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "website.#", "1"),
+						resource.TestCheckResourceAttr(resName, "website.0.index_document", "home.html"),
+						resource.TestCheckResourceAttr(resName, "website.0.routing_rule.#", "0"),
+					),
+				},
+				{
+					Config: tmpl.LifeCycleRemoved(t, objectStorageBucketName, testCluster, objectStorageKeyName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "website.#", "0"),
+						resource.TestCheckResourceAttr(resName, "website_endpoint", ""),
+					),
+				},
+			},
+		})
+	})
+}
+
 func TestAccResourceBucket_lifecycleNoID(t *testing.T) {
 	t.Parallel()
 
@@ -498,6 +697,9 @@ func TestAccResourceBucket_credsConfiged(t *testing.T) {
 func TestAccResourceBucket_tempKeys(t *testing.T) {
 	t.Parallel()
 
+	// Delete any temporary keys still held when the test ends
+	t.Cleanup(func() { helper.ShutdownObjTempKeyBrokers(context.Background()) })
+
 	resName := "linode_object_storage_bucket.foobar"
 	objectStorageBucketName := acctest.RandomWithPrefix("tf-test")
 	objectStorageKeyName := acctest.RandomWithPrefix("tf-test")
@@ -569,6 +771,40 @@ func checkBucketHasSSL(expected bool) func(*terraform.State) error {
 	}
 }
 
+// checkBucketHasCORS checks the bucket has a CORS configuration,
+// e.g. the default one of cors_enabled.
+func checkBucketHasCORS(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+
+		s3client, err := helper.S3Connection(
+			context.Background(),
+			rs.Primary.Attributes["endpoint"],
+			rs.Primary.Attributes["access_key"],
+			rs.Primary.Attributes["secret_key"],
+		)
+		if err != nil {
+			return err
+		}
+
+		output, err := s3client.GetBucketCors(context.Background(), &s3.GetBucketCorsInput{
+			Bucket: aws.String(rs.Primary.Attributes["label"]),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get cors of bucket %s: %w", rs.Primary.ID, err)
+		}
+
+		if len(output.CORSRules) == 0 {
+			return fmt.Errorf("bucket %s has no cors rules", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func checkBucketDestroy(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
 	for _, rs := range s.RootModule().Resources {
@@ -0,0 +1,347 @@
+//go:build unit
+
+package objbucket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandCORSRules(t *testing.T) {
+	rules, err := expandCORSRules(context.Background(), []any{
+		map[string]any{
+			"id":              "uploads",
+			"allowed_origins": []any{"https://example.com"},
+			"allowed_methods": []any{"GET", "PUT"},
+			"allowed_headers": []any{"*"},
+			"expose_headers":  []any{},
+			"max_age_seconds": 3600,
+		},
+		map[string]any{
+			"id":              "",
+			"allowed_origins": []any{"*"},
+			"allowed_methods": []any{"HEAD"},
+			"allowed_headers": []any{},
+			"expose_headers":  []any{"ETag"},
+			"max_age_seconds": 0,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, rules, 2)
+
+	assert.Equal(t, "uploads", aws.ToString(rules[0].ID))
+	assert.Equal(t, []string{"https://example.com"}, rules[0].AllowedOrigins)
+	assert.Equal(t, []string{"GET", "PUT"}, rules[0].AllowedMethods)
+	assert.Equal(t, []string{"*"}, rules[0].AllowedHeaders)
+	assert.Empty(t, rules[0].ExposeHeaders)
+	assert.Equal(t, int32(3600), aws.ToInt32(rules[0].MaxAgeSeconds))
+
+	assert.Nil(t, rules[1].ID)
+	assert.Equal(t, []string{"ETag"}, rules[1].ExposeHeaders)
+	assert.Nil(t, rules[1].MaxAgeSeconds)
+}
+
+func TestFlattenCORSRules(t *testing.T) {
+	result := flattenCORSRules(context.Background(), []s3types.CORSRule{
+		{
+			ID:             aws.String("uploads"),
+			AllowedOrigins: []string{"https://example.com"},
+			AllowedMethods: []string{"PUT"},
+			MaxAgeSeconds:  aws.Int32(60),
+		},
+		{
+			AllowedOrigins: []string{"*"},
+			AllowedMethods: []string{"GET"},
+		},
+	})
+
+	assert.Len(t, result, 2)
+
+	assert.Equal(t, "uploads", result[0]["id"])
+	assert.Equal(t, []string{"https://example.com"}, result[0]["allowed_origins"])
+	assert.Equal(t, []string{"PUT"}, result[0]["allowed_methods"])
+	assert.Equal(t, 60, result[0]["max_age_seconds"])
+
+	assert.NotContains(t, result[1], "id")
+	assert.NotContains(t, result[1], "max_age_seconds")
+}
+
+func TestExpandDefaultRetention(t *testing.T) {
+	rule, err := expandDefaultRetention(context.Background(), []any{
+		map[string]any{
+			"mode":  "GOVERNANCE",
+			"days":  30,
+			"years": 0,
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, rule)
+	assert.Equal(t, s3types.ObjectLockRetentionModeGovernance, rule.DefaultRetention.Mode)
+	assert.Equal(t, int32(30), *rule.DefaultRetention.Days)
+	assert.Nil(t, rule.DefaultRetention.Years)
+
+	rule, err = expandDefaultRetention(context.Background(), []any{})
+	assert.NoError(t, err)
+	assert.Nil(t, rule)
+}
+
+func TestFlattenDefaultRetention(t *testing.T) {
+	result := flattenDefaultRetention(context.Background(), &s3types.ObjectLockRule{
+		DefaultRetention: &s3types.DefaultRetention{
+			Mode:  s3types.ObjectLockRetentionModeCompliance,
+			Years: aws.Int32(7),
+		},
+	})
+
+	assert.Len(t, result, 1)
+	assert.Equal(t, "COMPLIANCE", result[0]["mode"])
+	assert.Equal(t, 7, result[0]["years"])
+	assert.NotContains(t, result[0], "days")
+
+	assert.Nil(t, flattenDefaultRetention(context.Background(), nil))
+	assert.Nil(t, flattenDefaultRetention(context.Background(), &s3types.ObjectLockRule{}))
+}
+
+func TestExpandWebsite(t *testing.T) {
+	config := expandWebsite(context.Background(), []any{
+		map[string]any{
+			"index_document": "index.html",
+			"error_document": "",
+			"routing_rule": []any{
+				map[string]any{
+					"condition": []any{
+						map[string]any{
+							"key_prefix_equals":               "docs/",
+							"http_error_code_returned_equals": "",
+						},
+					},
+					"redirect": []any{
+						map[string]any{
+							"host_name":               "",
+							"http_redirect_code":      "301",
+							"protocol":                "https",
+							"replace_key_prefix_with": "documents/",
+							"replace_key_with":        "",
+						},
+					},
+				},
+			},
+		},
+	})
+
+	assert.NotNil(t, config)
+	assert.Equal(t, "index.html", *config.IndexDocument.Suffix)
+	assert.Nil(t, config.ErrorDocument)
+	assert.Len(t, config.RoutingRules, 1)
+	assert.Equal(t, "docs/", *config.RoutingRules[0].Condition.KeyPrefixEquals)
+	assert.Nil(t, config.RoutingRules[0].Condition.HttpErrorCodeReturnedEquals)
+	assert.Equal(t, "301", *config.RoutingRules[0].Redirect.HttpRedirectCode)
+	assert.Equal(t, s3types.ProtocolHttps, config.RoutingRules[0].Redirect.Protocol)
+	assert.Equal(t, "documents/", *config.RoutingRules[0].Redirect.ReplaceKeyPrefixWith)
+	assert.Nil(t, config.RoutingRules[0].Redirect.HostName)
+
+	assert.Nil(t, expandWebsite(context.Background(), []any{}))
+}
+
+func TestFlattenWebsite(t *testing.T) {
+	result := flattenWebsite(context.Background(), &s3.GetBucketWebsiteOutput{
+		IndexDocument: &s3types.IndexDocument{Suffix: aws.String("index.html")},
+		ErrorDocument: &s3types.ErrorDocument{Key: aws.String("404.html")},
+		RoutingRules: []s3types.RoutingRule{
+			{
+				Redirect: &s3types.Redirect{
+					HostName: aws.String("example.com"),
+				},
+			},
+		},
+	})
+
+	assert.Len(t, result, 1)
+	assert.Equal(t, "index.html", result[0]["index_document"])
+	assert.Equal(t, "404.html", result[0]["error_document"])
+
+	rules := result[0]["routing_rule"].([]map[string]any)
+	assert.Len(t, rules, 1)
+	assert.NotContains(t, rules[0], "condition")
+	assert.Equal(t, "example.com", rules[0]["redirect"].([]map[string]any)[0]["host_name"])
+
+	assert.Nil(t, flattenWebsite(context.Background(), nil))
+	assert.Nil(t, flattenWebsite(context.Background(), &s3.GetBucketWebsiteOutput{}))
+}
+
+func TestComputeWebsiteEndpoint(t *testing.T) {
+	endpoint := computeWebsiteEndpoint(linodego.ObjectStorageBucket{
+		Label:    "mybucket",
+		Hostname: "mybucket.us-east-1.linodeobjects.com",
+	})
+
+	assert.Equal(t, "mybucket.website-us-east-1.linodeobjects.com", endpoint)
+}
+
+func TestResourceEndpointOverride(t *testing.T) {
+	var mu sync.Mutex
+	buckets := make(map[string]string)
+
+	// A fake S3-compatible server addressed in the request path
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		bucket := strings.Trim(r.URL.Path, "/")
+		_, exists := buckets[bucket]
+
+		switch {
+		case r.Method == http.MethodPut:
+			// Both bucket creation and ACL updates carry the canned ACL
+			buckets[bucket] = r.Header.Get("X-Amz-Acl")
+		case r.Method == http.MethodHead && exists:
+		case r.Method == http.MethodDelete && exists:
+			delete(buckets, bucket)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	// The Linode client is left unconfigured so any Linode API call fails the test
+	meta := &helper.ProviderMeta{
+		Config: &helper.Config{
+			ObjEndpointOverride: server.URL,
+			ObjUsePathStyle:     true,
+			ObjAccessKey:        "access",
+			ObjSecretKey:        "secret",
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]any{
+		"cluster": "local-1",
+		"label":   "test-bucket",
+		"acl":     "public-read",
+	})
+
+	diags := createResource(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "local-1:test-bucket", d.Id())
+	assert.Equal(t, server.URL, d.Get("endpoint"))
+	assert.Equal(t, "public-read", d.Get("acl"))
+	assert.Equal(t, "public-read", buckets["test-bucket"])
+
+	diags = deleteResource(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.NotContains(t, buckets, "test-bucket")
+
+	// Buckets deleted outside of Terraform are removed from state
+	diags = readResource(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Empty(t, d.Id())
+}
+
+func TestUpdateResourceRemoveCORSRules(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+
+	// A fake server for both the Linode API and S3, addressed in the request path
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		requests = append(requests, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/object-storage/buckets/us-east-1/test-bucket":
+			w.Write([]byte(`{"cluster": "us-east-1", "label": "test-bucket", "hostname": "test-bucket.us-east-1.linodeobjects.com"}`))
+		case "/v4/object-storage/buckets/us-east-1/test-bucket/access":
+			w.Write([]byte(`{"acl": "private", "cors_enabled": true}`))
+		case "/test-bucket":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	meta := &helper.ProviderMeta{
+		Client: client,
+		Config: &helper.Config{ObjUsePathStyle: true},
+	}
+
+	state := &terraform.InstanceState{
+		ID: "us-east-1:test-bucket",
+		Attributes: map[string]string{
+			"cluster":                       "us-east-1",
+			"label":                         "test-bucket",
+			"acl":                           "private",
+			"cors_enabled":                  "true",
+			"endpoint":                      server.URL,
+			"access_key":                    "access",
+			"secret_key":                    "secret",
+			"cors_rule.#":                   "1",
+			"cors_rule.0.allowed_origins.#": "1",
+			"cors_rule.0.allowed_origins.0": "https://example.com",
+			"cors_rule.0.allowed_methods.#": "1",
+			"cors_rule.0.allowed_methods.0": "GET",
+			"cors_rule.0.max_age_seconds":   "0",
+			"cors_rule.0.allowed_headers.#": "0",
+			"cors_rule.0.expose_headers.#":  "0",
+			"cors_rule.0.id":                "test-rule",
+			"versioning":                    "false",
+			"object_lock_enabled":           "false",
+			"lifecycle_rule.#":              "0",
+			"website.#":                     "0",
+			"cert.#":                        "0",
+			"default_retention.#":           "0",
+			"hostname":                      "test-bucket.us-east-1.linodeobjects.com",
+			"website_endpoint":              "",
+		},
+	}
+
+	// All cors_rule blocks are removed from the configuration
+	r := Resource()
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]any{
+		"cluster":    "us-east-1",
+		"label":      "test-bucket",
+		"access_key": "access",
+		"secret_key": "secret",
+	}), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diags := updateResource(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+
+	// The default configuration of cors_enabled is applied again once the custom rules are deleted
+	assert.Equal(t, []string{
+		"DELETE /test-bucket?cors=",
+		"POST /v4/object-storage/buckets/us-east-1/test-bucket/access?",
+		"GET /v4/object-storage/buckets/us-east-1/test-bucket?",
+		"GET /v4/object-storage/buckets/us-east-1/test-bucket/access?",
+	}, requests)
+	assert.True(t, d.Get("cors_enabled").(bool))
+}
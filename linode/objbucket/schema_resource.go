@@ -1,6 +1,10 @@
 package objbucket
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 var resourceSchema = map[string]*schema.Schema{
 	"secret_key": {
@@ -53,6 +57,26 @@ var resourceSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Elem:        resourceLifeCycle(),
 	},
+	"cors_rule": {
+		Type: schema.TypeList,
+		Description: "CORS rules to be applied to the bucket. " +
+			"When defined, these rules replace the default CORS configuration enabled by cors_enabled, " +
+			"which is restored once all rules are removed.",
+		Optional: true,
+		Elem:     resourceCORSRule(),
+	},
+	"website": {
+		Type:        schema.TypeList,
+		Description: "The static website hosting configuration of the bucket.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem:        resourceWebsite(),
+	},
+	"website_endpoint": {
+		Type:        schema.TypeString,
+		Description: "The endpoint the bucket is served from as a static website.",
+		Computed:    true,
+	},
 	"hostname": {
 		Type: schema.TypeString,
 		Description: "The hostname where this bucket can be accessed. " +
@@ -65,6 +89,22 @@ var resourceSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Computed:    true,
 	},
+	"object_lock_enabled": {
+		Type: schema.TypeBool,
+		Description: "Whether object lock is enabled for the bucket. " +
+			"Object lock can't be disabled once it has been enabled and requires versioning. " +
+			"Buckets with object lock are created through the S3 API using access_key and secret_key.",
+		Optional: true,
+		ForceNew: true,
+	},
+	"default_retention": {
+		Type:         schema.TypeList,
+		Description:  "The default retention rule applied to new objects put in the bucket.",
+		Optional:     true,
+		MaxItems:     1,
+		RequiredWith: []string{"object_lock_enabled"},
+		Elem:         resourceDefaultRetention(),
+	},
 	"cert": {
 		Type:        schema.TypeList,
 		Description: "The cert used by this Object Storage Bucket.",
@@ -153,3 +193,149 @@ var resourceSchemaNonCurrentExp = map[string]*schema.Schema{
 		Required:    true,
 	},
 }
+
+var resourceSchemaCORSRule = map[string]*schema.Schema{
+	"id": {
+		Type:        schema.TypeString,
+		Description: "The unique identifier for the rule.",
+		Optional:    true,
+	},
+	"allowed_origins": {
+		Type:        schema.TypeList,
+		Description: "The origins that are allowed to make cross-origin requests to the bucket.",
+		Required:    true,
+		MinItems:    1,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"allowed_methods": {
+		Type:        schema.TypeList,
+		Description: "The HTTP methods that are allowed for cross-origin requests (GET, PUT, POST, DELETE, HEAD).",
+		Required:    true,
+		MinItems:    1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"GET", "PUT", "POST", "DELETE", "HEAD"}, false),
+		},
+	},
+	"allowed_headers": {
+		Type:        schema.TypeList,
+		Description: "The headers that are allowed in a preflight request.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"expose_headers": {
+		Type:        schema.TypeList,
+		Description: "The response headers that browsers are allowed to access.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"max_age_seconds": {
+		Type:         schema.TypeInt,
+		Description:  "The time in seconds that browsers can cache the response for a preflight request.",
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+}
+
+var resourceSchemaDefaultRetention = map[string]*schema.Schema{
+	"mode": {
+		Type:        schema.TypeString,
+		Description: "The default object lock retention mode (GOVERNANCE, COMPLIANCE).",
+		Required:    true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(s3types.ObjectLockRetentionModeGovernance),
+			string(s3types.ObjectLockRetentionModeCompliance),
+		}, false),
+	},
+	"days": {
+		Type:         schema.TypeInt,
+		Description:  "The number of days objects are retained for.",
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		ExactlyOneOf: []string{"default_retention.0.days", "default_retention.0.years"},
+	},
+	"years": {
+		Type:         schema.TypeInt,
+		Description:  "The number of years objects are retained for.",
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+}
+
+var resourceSchemaWebsite = map[string]*schema.Schema{
+	"index_document": {
+		Type:        schema.TypeString,
+		Description: "The suffix appended to requests for a directory, e.g. index.html.",
+		Required:    true,
+	},
+	"error_document": {
+		Type:        schema.TypeString,
+		Description: "The object key returned when an error occurs.",
+		Optional:    true,
+	},
+	"routing_rule": {
+		Type:        schema.TypeList,
+		Description: "The rules redirecting requests matching a condition.",
+		Optional:    true,
+		Elem:        resourceWebsiteRoutingRule(),
+	},
+}
+
+var resourceSchemaWebsiteRoutingRule = map[string]*schema.Schema{
+	"condition": {
+		Type:        schema.TypeList,
+		Description: "The condition that must be met for the redirect to apply.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_prefix_equals": {
+					Type:        schema.TypeString,
+					Description: "The object key prefix the request must match.",
+					Optional:    true,
+				},
+				"http_error_code_returned_equals": {
+					Type:        schema.TypeString,
+					Description: "The HTTP error code the request must result in.",
+					Optional:    true,
+				},
+			},
+		},
+	},
+	"redirect": {
+		Type:        schema.TypeList,
+		Description: "The redirect applied to matching requests.",
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"host_name": {
+					Type:        schema.TypeString,
+					Description: "The host name to redirect to.",
+					Optional:    true,
+				},
+				"http_redirect_code": {
+					Type:        schema.TypeString,
+					Description: "The HTTP redirect code of the response.",
+					Optional:    true,
+				},
+				"protocol": {
+					Type:         schema.TypeString,
+					Description:  "The protocol to redirect with (http, https).",
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"http", "https"}, false),
+				},
+				"replace_key_prefix_with": {
+					Type:        schema.TypeString,
+					Description: "The object key prefix that replaces key_prefix_equals in the redirect.",
+					Optional:    true,
+				},
+				"replace_key_with": {
+					Type:        schema.TypeString,
+					Description: "The object key that replaces the whole key in the redirect.",
+					Optional:    true,
+				},
+			},
+		},
+	},
+}
@@ -18,6 +18,8 @@ type TemplateData struct {
 	Cert    string
 	PrivKey string
 	Cluster string
+
+	Endpoint string
 }
 
 func Basic(t *testing.T, label, cluster string) string {
@@ -40,6 +42,16 @@ func Access(t *testing.T, label, cluster, acl string, cors bool) string {
 		})
 }
 
+func EndpointOverride(t *testing.T, label, cluster, endpoint, acl string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_bucket_endpoint_override", TemplateData{
+			Label:    label,
+			ACL:      acl,
+			Cluster:  cluster,
+			Endpoint: endpoint,
+		})
+}
+
 func Cert(t *testing.T, label, cluster, cert, privKey string) string {
 	return acceptance.ExecuteTemplate(t,
 		"object_bucket_cert", TemplateData{
@@ -96,6 +108,69 @@ func LifeCycleRemoved(t *testing.T, label, cluster, keyName string) string {
 		})
 }
 
+func CORS(t *testing.T, label, cluster, keyName string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_bucket_cors", TemplateData{
+			Key:     objkey.TemplateData{Label: keyName},
+			Label:   label,
+			Cluster: cluster,
+		})
+}
+
+func CORSUpdates(t *testing.T, label, cluster, keyName string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_bucket_cors_updates", TemplateData{
+			Key:     objkey.TemplateData{Label: keyName},
+			Label:   label,
+			Cluster: cluster,
+		})
+}
+
+func ObjectLock(t *testing.T, label, cluster, keyName string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_bucket_object_lock", TemplateData{
+			Key:     objkey.TemplateData{Label: keyName},
+			Label:   label,
+			Cluster: cluster,
+		})
+}
+
+func ObjectLockUpdates(t *testing.T, label, cluster, keyName string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_bucket_object_lock_updates", TemplateData{
+			Key:     objkey.TemplateData{Label: keyName},
+			Label:   label,
+			Cluster: cluster,
+		})
+}
+
+func ObjectLockNoRetention(t *testing.T, label, cluster, keyName string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_bucket_object_lock_no_retention", TemplateData{
+			Key:     objkey.TemplateData{Label: keyName},
+			Label:   label,
+			Cluster: cluster,
+		})
+}
+
+func Website(t *testing.T, label, cluster, keyName string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_bucket_website", TemplateData{
+			Key:     objkey.TemplateData{Label: keyName},
+			Label:   label,
+			Cluster: cluster,
+		})
+}
+
+func WebsiteUpdates(t *testing.T, label, cluster, keyName string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_bucket_website_updates", TemplateData{
+			Key:     objkey.TemplateData{Label: keyName},
+			Label:   label,
+			Cluster: cluster,
+		})
+}
+
 func TempKeys(t *testing.T, label, cluster, keyName string) string {
 	return acceptance.ExecuteTemplate(t,
 		"object_bucket_temp_keys", TemplateData{
@@ -0,0 +1,36 @@
+package objbucketpolicy
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func populateLogAttributes(ctx context.Context, d *schema.ResourceData) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"bucket":  d.Get("bucket"),
+		"cluster": d.Get("cluster"),
+	})
+}
+
+func policyDiffSuppressFunc(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	return helper.JSONStringsEquivalent(oldValue, newValue)
+}
+
+// flattenPolicy returns the remote policy, preserving the declared
+// representation when both documents are semantically equal.
+func flattenPolicy(remotePolicy, declaredPolicy string) (string, error) {
+	if helper.JSONStringsEquivalent(remotePolicy, declaredPolicy) {
+		return declaredPolicy, nil
+	}
+
+	return helper.NormalizeJSON(remotePolicy)
+}
+
+func isNoSuchBucketPolicyErr(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "NoSuchBucketPolicy"
+}
@@ -0,0 +1,205 @@
+package objbucketpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/obj"
+	"github.com/linode/terraform-provider-linode/v2/linode/objbucket"
+)
+
+func Resource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceSchema,
+		ReadContext:   readResource,
+		CreateContext: createResource,
+		UpdateContext: updateResource,
+		DeleteContext: deleteResource,
+		Importer: &schema.ResourceImporter{
+			StateContext: importResource,
+		},
+	}
+}
+
+func importResource(
+	ctx context.Context, d *schema.ResourceData, meta any,
+) ([]*schema.ResourceData, error) {
+	cluster, bucket, err := objbucket.DecodeBucketID(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("bucket", bucket)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func readResource(
+	ctx context.Context, d *schema.ResourceData, meta any,
+) diag.Diagnostics {
+	ctx = populateLogAttributes(ctx, d)
+	tflog.Debug(ctx, "reading linode_object_storage_bucket_policy")
+
+	s3client, teardownKeysCleanUp, diags := getS3Client(ctx, d, meta, "read_only")
+	if diags != nil {
+		return diags
+	}
+
+	if teardownKeysCleanUp != nil {
+		defer teardownKeysCleanUp()
+	}
+
+	bucket := d.Get("bucket").(string)
+
+	tflog.Debug(ctx, "getting bucket policy from the API")
+	policyOutput, err := s3client.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if isNoSuchBucketPolicyErr(err) || helper.IsObjNotFoundErr(err) {
+			tflog.Warn(ctx, fmt.Sprintf(
+				"[WARN] removing Object Storage Bucket Policy %q from state because it no longer exists",
+				d.Id(),
+			))
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to get policy for bucket %s: %s", bucket, err)
+	}
+
+	policy, err := flattenPolicy(aws.ToString(policyOutput.Policy), d.Get("policy").(string))
+	if err != nil {
+		return diag.Errorf("failed to parse policy for bucket %s: %s", bucket, err)
+	}
+
+	d.Set("policy", policy)
+
+	// Compute s3 endpoint when it's not configured by the user
+	if _, ok := d.GetOk("endpoint"); !ok {
+		tflog.Debug(ctx, "'endpoint' wasn't configured, computing it from cluster name")
+		endpoint, err := helper.ComputeS3Endpoint(ctx, d, meta)
+		if err != nil {
+			return diag.Errorf("failed to compute object storage endpoint: %s", err)
+		}
+		d.Set("endpoint", endpoint)
+	}
+
+	return nil
+}
+
+func createResource(
+	ctx context.Context, d *schema.ResourceData, meta any,
+) diag.Diagnostics {
+	ctx = populateLogAttributes(ctx, d)
+	tflog.Debug(ctx, "creating linode_object_storage_bucket_policy")
+
+	if diags := putBucketPolicy(ctx, d, meta); diags != nil {
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", d.Get("cluster").(string), d.Get("bucket").(string)))
+
+	return readResource(ctx, d, meta)
+}
+
+func updateResource(
+	ctx context.Context, d *schema.ResourceData, meta any,
+) diag.Diagnostics {
+	ctx = populateLogAttributes(ctx, d)
+	tflog.Debug(ctx, "updating linode_object_storage_bucket_policy")
+
+	if d.HasChange("policy") {
+		tflog.Debug(ctx, "'policy' changes detected, will update bucket policy")
+		if diags := putBucketPolicy(ctx, d, meta); diags != nil {
+			return diags
+		}
+	}
+
+	return readResource(ctx, d, meta)
+}
+
+func deleteResource(
+	ctx context.Context, d *schema.ResourceData, meta any,
+) diag.Diagnostics {
+	ctx = populateLogAttributes(ctx, d)
+	tflog.Debug(ctx, "deleting linode_object_storage_bucket_policy")
+
+	s3client, teardownKeysCleanUp, diags := getS3Client(ctx, d, meta, "read_write")
+	if diags != nil {
+		return diags
+	}
+
+	if teardownKeysCleanUp != nil {
+		defer teardownKeysCleanUp()
+	}
+
+	bucket := d.Get("bucket").(string)
+
+	tflog.Debug(ctx, "calling delete bucket policy API")
+	_, err := s3client.DeleteBucketPolicy(ctx, &s3.DeleteBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil && !isNoSuchBucketPolicyErr(err) && !helper.IsObjNotFoundErr(err) {
+		return diag.Errorf("failed to delete policy for bucket %s: %s", bucket, err)
+	}
+
+	return nil
+}
+
+func putBucketPolicy(
+	ctx context.Context, d *schema.ResourceData, meta any,
+) diag.Diagnostics {
+	s3client, teardownKeysCleanUp, diags := getS3Client(ctx, d, meta, "read_write")
+	if diags != nil {
+		return diags
+	}
+
+	if teardownKeysCleanUp != nil {
+		defer teardownKeysCleanUp()
+	}
+
+	bucket := d.Get("bucket").(string)
+
+	putInput := &s3.PutBucketPolicyInput{
+		Bucket: aws.String(bucket),
+		Policy: aws.String(d.Get("policy").(string)),
+	}
+
+	tflog.Debug(ctx, "making put bucket policy call to the API", map[string]any{
+		"input": putInput,
+	})
+	if _, err := s3client.PutBucketPolicy(ctx, putInput); err != nil {
+		return diag.Errorf("failed to put policy for bucket %s: %s", bucket, err)
+	}
+
+	return nil
+}
+
+func getS3Client(
+	ctx context.Context, d *schema.ResourceData, meta any, permission string,
+) (*s3.Client, func(), diag.Diagnostics) {
+	cluster := d.Get("cluster").(string)
+	bucket := d.Get("bucket").(string)
+
+	objKeys, diags, teardownKeysCleanUp := obj.GetObjKeys(ctx, d, meta.(*helper.ProviderMeta), bucket, cluster, permission)
+	if diags != nil {
+		return nil, nil, diags
+	}
+
+	s3client, err := helper.S3ConnectionFromData(ctx, d, meta, objKeys.AccessKey, objKeys.SecretKey)
+	if err != nil {
+		if teardownKeysCleanUp != nil {
+			teardownKeysCleanUp()
+		}
+		return nil, nil, diag.FromErr(err)
+	}
+
+	return s3client, teardownKeysCleanUp, nil
+}
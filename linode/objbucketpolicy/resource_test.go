@@ -0,0 +1,106 @@
+//go:build integration
+
+package objbucketpolicy_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/objbucketpolicy/tmpl"
+)
+
+var testCluster string
+
+func init() {
+	cluster, err := acceptance.GetRandomOBJCluster()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testCluster = cluster
+}
+
+func TestAccResourceBucketPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	acceptance.RunTestRetry(t, 5, func(retryT *acceptance.TRetry) {
+		resName := "linode_object_storage_bucket_policy.foobar"
+		bucketName := acctest.RandomWithPrefix("tf-test")
+		keyName := acctest.RandomWithPrefix("tf_test")
+
+		resource.Test(retryT, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.Basic(t, bucketName, testCluster, keyName, "static"),
+					Check: resource.ComposeTestCheckFunc(
+						checkBucketPolicyExists,
+						resource.TestCheckResourceAttr(resName, "bucket", bucketName),
+						resource.TestCheckResourceAttr(resName, "cluster", testCluster),
+						resource.TestCheckResourceAttrSet(resName, "policy"),
+						resource.TestCheckResourceAttrSet(resName, "endpoint"),
+					),
+				},
+				{
+					Config:   tmpl.Basic(t, bucketName, testCluster, keyName, "static"),
+					PlanOnly: true,
+				},
+				{
+					ResourceName:            resName,
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"access_key", "secret_key", "policy"},
+				},
+				{
+					Config: tmpl.Basic(t, bucketName, testCluster, keyName, "assets"),
+					Check: resource.ComposeTestCheckFunc(
+						checkBucketPolicyExists,
+						resource.TestCheckResourceAttr(resName, "bucket", bucketName),
+					),
+				},
+			},
+		})
+	})
+}
+
+func checkBucketPolicyExists(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_object_storage_bucket_policy" {
+			continue
+		}
+
+		s3client, err := helper.S3Connection(
+			context.Background(),
+			rs.Primary.Attributes["endpoint"],
+			rs.Primary.Attributes["access_key"],
+			rs.Primary.Attributes["secret_key"],
+		)
+		if err != nil {
+			return err
+		}
+
+		bucket := rs.Primary.Attributes["bucket"]
+		output, err := s3client.GetBucketPolicy(context.Background(), &s3.GetBucketPolicyInput{
+			Bucket: aws.String(bucket),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get policy for bucket %s: %s", bucket, err)
+		}
+
+		if !helper.JSONStringsEquivalent(aws.ToString(output.Policy), rs.Primary.Attributes["policy"]) {
+			return fmt.Errorf("policy of bucket %s does not match the state", bucket)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package objbucketpolicy
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceSchema = map[string]*schema.Schema{
+	"bucket": {
+		Type:        schema.TypeString,
+		Description: "The target bucket to apply the policy to.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	"cluster": {
+		Type:        schema.TypeString,
+		Description: "The target cluster that the bucket is in.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	"policy": {
+		Type:             schema.TypeString,
+		Description:      "The JSON-encoded bucket policy document.",
+		Required:         true,
+		ValidateFunc:     validation.StringIsJSON,
+		DiffSuppressFunc: policyDiffSuppressFunc,
+	},
+	"secret_key": {
+		Type: schema.TypeString,
+		Description: "The S3 secret key with access to the target bucket. " +
+			"If not specified with the resource, the value will be read from provider-level obj_secret_key, " +
+			"or, generated implicitly at apply-time if obj_use_temp_keys in provider configuration is set.",
+		Optional:  true,
+		Sensitive: true,
+	},
+	"access_key": {
+		Type: schema.TypeString,
+		Description: "The S3 access key with access to the target bucket. " +
+			"If not specified with the resource, the value will be read from provider-level obj_access_key, " +
+			"or, generated implicitly at apply-time if obj_use_temp_keys in provider configuration is set.",
+		Optional: true,
+	},
+	"endpoint": {
+		Type:        schema.TypeString,
+		Description: "The endpoint for the bucket used for s3 connections.",
+		Optional:    true,
+		Computed:    true,
+	},
+}
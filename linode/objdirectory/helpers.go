@@ -0,0 +1,174 @@
+package objdirectory
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/obj"
+)
+
+// sniffLength is the number of bytes considered when detecting
+// the content type of a file from its content.
+const sniffLength = 512
+
+func populateLogAttributes(ctx context.Context, d *schema.ResourceData) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"bucket":     d.Get("bucket"),
+		"cluster":    d.Get("cluster"),
+		"prefix":     d.Get("prefix"),
+		"source_dir": d.Get("source_dir"),
+	})
+}
+
+// listLocalFiles walks the source directory and returns the md5 hashes of all
+// regular files matching the include and exclude patterns, keyed by their
+// slash-separated paths relative to the source directory.
+func listLocalFiles(sourceDir string, include, exclude []string) (map[string]string, error) {
+	includePatterns, err := compileGlobs(include)
+	if err != nil {
+		return nil, err
+	}
+
+	excludePatterns, err := compileGlobs(exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+
+	err = filepath.WalkDir(filepath.Clean(sourceDir), func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+
+		if len(includePatterns) > 0 && !matchesAny(includePatterns, relPath) {
+			return nil
+		}
+
+		if matchesAny(excludePatterns, relPath) {
+			return nil
+		}
+
+		hash, err := obj.ComputeFileMD5(filePath)
+		if err != nil {
+			return err
+		}
+
+		result[relPath] = hash
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files in %s: %w", sourceDir, err)
+	}
+
+	return result, nil
+}
+
+// compileGlobs converts the given glob patterns into regular expressions.
+// `**` matches any number of directories, `*` and `?` never match a `/`.
+func compileGlobs(patterns []string) ([]*regexp.Regexp, error) {
+	result := make([]*regexp.Regexp, len(patterns))
+
+	for i, pattern := range patterns {
+		var expr strings.Builder
+		expr.WriteString("^")
+
+		for j := 0; j < len(pattern); j++ {
+			switch c := pattern[j]; {
+			case strings.HasPrefix(pattern[j:], "**/"):
+				expr.WriteString("(?:.*/)?")
+				j += 2
+			case strings.HasPrefix(pattern[j:], "**"):
+				expr.WriteString(".*")
+				j++
+			case c == '*':
+				expr.WriteString("[^/]*")
+			case c == '?':
+				expr.WriteString("[^/]")
+			default:
+				expr.WriteString(regexp.QuoteMeta(string(c)))
+			}
+		}
+
+		expr.WriteString("$")
+
+		compiled, err := regexp.Compile(expr.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid glob pattern %q: %w", pattern, err)
+		}
+
+		result[i] = compiled
+	}
+
+	return result, nil
+}
+
+func matchesAny(patterns []*regexp.Regexp, name string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// detectContentType determines the content type of a file, first from the
+// configured overrides, then from its extension and lastly from its content.
+func detectContentType(filePath string, overrides map[string]string) (string, error) {
+	ext := strings.ToLower(filepath.Ext(filePath))
+
+	for overrideExt, contentType := range overrides {
+		if strings.ToLower("."+strings.TrimPrefix(overrideExt, ".")) == ext {
+			return contentType, nil
+		}
+	}
+
+	if contentType := mime.TypeByExtension(ext); contentType != "" {
+		return contentType, nil
+	}
+
+	file, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	buffer := make([]byte, sniffLength)
+	n, err := io.ReadFull(file, buffer)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	return http.DetectContentType(buffer[:n]), nil
+}
+
+// buildObjectKey returns the object key of the given relative file path.
+func buildObjectKey(prefix, relPath string) string {
+	if prefix == "" {
+		return relPath
+	}
+
+	return path.Join(prefix, relPath)
+}
@@ -0,0 +1,109 @@
+//go:build unit
+
+package objdirectory
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/obj"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestDirectory(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, content := range files {
+		filePath := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o700); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestCompileGlobs(t *testing.T) {
+	patterns, err := compileGlobs([]string{"**/*.html", "assets/*.css", "?.txt"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.True(t, patterns[0].MatchString("index.html"))
+	assert.True(t, patterns[0].MatchString("blog/posts/first.html"))
+	assert.False(t, patterns[0].MatchString("index.htm"))
+
+	assert.True(t, patterns[1].MatchString("assets/main.css"))
+	assert.False(t, patterns[1].MatchString("assets/vendor/main.css"))
+
+	assert.True(t, patterns[2].MatchString("a.txt"))
+	assert.False(t, patterns[2].MatchString("ab.txt"))
+}
+
+func TestListLocalFiles(t *testing.T) {
+	dir := createTestDirectory(t, map[string]string{
+		"index.html":        "<html></html>",
+		"assets/main.css":   "body {}",
+		"assets/main.css.m": "map",
+		"drafts/post.html":  "draft",
+	})
+
+	files, err := listLocalFiles(dir, []string{"**/*.html", "**/*.css"}, []string{"drafts/**"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, files, 2)
+	assert.Contains(t, files, "index.html")
+	assert.Contains(t, files, "assets/main.css")
+	assert.NotContains(t, files, "assets/main.css.m")
+	assert.NotContains(t, files, "drafts/post.html")
+
+	hash, err := obj.ComputeFileMD5(filepath.Join(dir, "assets", "main.css"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, hash, files["assets/main.css"])
+
+	allFiles, err := listLocalFiles(dir, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, allFiles, 4)
+}
+
+func TestDetectContentType(t *testing.T) {
+	dir := createTestDirectory(t, map[string]string{
+		"index.html":       "<html></html>",
+		"data.webmanifest": "{}",
+		"README":           "plain text",
+	})
+
+	contentType, err := detectContentType(filepath.Join(dir, "index.html"), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "text/html; charset=utf-8", contentType)
+
+	contentType, err = detectContentType(
+		filepath.Join(dir, "data.webmanifest"),
+		map[string]string{"webmanifest": "application/manifest+json"},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "application/manifest+json", contentType)
+
+	contentType, err = detectContentType(filepath.Join(dir, "README"), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "text/plain; charset=utf-8", contentType)
+}
+
+func TestBuildObjectKey(t *testing.T) {
+	assert.Equal(t, "index.html", buildObjectKey("", "index.html"))
+	assert.Equal(t, "site/index.html", buildObjectKey("site", "index.html"))
+	assert.Equal(t, "site/assets/main.css", buildObjectKey("site/", "assets/main.css"))
+}
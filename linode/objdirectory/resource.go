@@ -0,0 +1,363 @@
+package objdirectory
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/obj"
+)
+
+// deleteObjectsBatchSize is the maximum number of keys
+// accepted by a single DeleteObjects request.
+const deleteObjectsBatchSize = 1000
+
+func Resource() *schema.Resource {
+	return &schema.Resource{
+		Schema: resourceSchema,
+
+		ReadContext:   readResource,
+		CreateContext: createResource,
+		UpdateContext: updateResource,
+		DeleteContext: deleteResource,
+
+		CustomizeDiff: diffResource,
+	}
+}
+
+func readResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	ctx = populateLogAttributes(ctx, d)
+	tflog.Debug(ctx, "reading linode_object_storage_directory")
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	s3client, teardownKeysCleanUp, diags := getS3Client(ctx, d, meta, "read_only")
+	if diags != nil {
+		return diags
+	}
+
+	if teardownKeysCleanUp != nil {
+		defer teardownKeysCleanUp()
+	}
+
+	remoteETags, err := listRemoteETags(ctx, s3client, bucket, buildObjectKey(prefix, ""))
+	if err != nil {
+		if helper.IsObjNotFoundErr(err) {
+			d.SetId("")
+			tflog.Warn(ctx, "couldn't find the bucket, removing the directory from the TF state")
+			return nil
+		}
+		return diag.Errorf("failed to list objects in bucket %s: %s", bucket, err)
+	}
+
+	files := make(map[string]string)
+
+	for relPath, hash := range d.Get("files").(map[string]any) {
+		etag, ok := remoteETags[buildObjectKey(prefix, relPath)]
+		if !ok {
+			tflog.Debug(ctx, fmt.Sprintf("object for file %s no longer exists", relPath))
+			continue
+		}
+
+		// ETags of multipart uploads are not md5 hashes of the content
+		if obj.IsMultipartETag(etag) {
+			files[relPath] = hash.(string)
+			continue
+		}
+
+		files[relPath] = etag
+	}
+
+	d.Set("files", files)
+
+	// Compute s3 endpoint when it's not configured by the user
+	if _, ok := d.GetOk("endpoint"); !ok {
+		tflog.Debug(ctx, "'endpoint' wasn't configured, computing it from cluster name")
+		endpoint, err := helper.ComputeS3Endpoint(ctx, d, meta)
+		if err != nil {
+			return diag.Errorf("failed to compute object storage endpoint: %s", err)
+		}
+		d.Set("endpoint", endpoint)
+	}
+
+	return nil
+}
+
+func createResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	ctx = populateLogAttributes(ctx, d)
+	tflog.Debug(ctx, "creating linode_object_storage_directory")
+
+	if diags := syncDirectory(ctx, d, meta, map[string]string{}, true); diags != nil {
+		return diags
+	}
+
+	d.SetId(buildDirectoryID(d))
+
+	return readResource(ctx, d, meta)
+}
+
+func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	ctx = populateLogAttributes(ctx, d)
+	tflog.Debug(ctx, "updating linode_object_storage_directory")
+
+	oldFiles := make(map[string]string)
+
+	oldFilesRaw, _ := d.GetChange("files")
+	for relPath, hash := range oldFilesRaw.(map[string]any) {
+		oldFiles[relPath] = hash.(string)
+	}
+
+	// Upload all files again when the object attributes change
+	reuploadAll := d.HasChanges("acl", "cache_control", "content_types")
+
+	if diags := syncDirectory(ctx, d, meta, oldFiles, reuploadAll); diags != nil {
+		return diags
+	}
+
+	return readResource(ctx, d, meta)
+}
+
+func deleteResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	ctx = populateLogAttributes(ctx, d)
+	tflog.Debug(ctx, "deleting linode_object_storage_directory")
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	s3client, teardownKeysCleanUp, diags := getS3Client(ctx, d, meta, "read_write")
+	if diags != nil {
+		return diags
+	}
+
+	if teardownKeysCleanUp != nil {
+		defer teardownKeysCleanUp()
+	}
+
+	keys := make([]string, 0)
+	for relPath := range d.Get("files").(map[string]any) {
+		keys = append(keys, buildObjectKey(prefix, relPath))
+	}
+
+	if err := deleteObjects(ctx, s3client, bucket, keys); err != nil && !helper.IsObjNotFoundErr(err) {
+		return diag.Errorf("failed to delete objects in bucket %s: %s", bucket, err)
+	}
+
+	return nil
+}
+
+func diffResource(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("include") || !d.NewValueKnown("exclude") {
+		tflog.Debug(ctx, "source directory isn't known yet, 'files' will be computed")
+		return d.SetNewComputed("files")
+	}
+
+	localFiles, err := listLocalFiles(
+		d.Get("source_dir").(string),
+		helper.ExpandStringList(d.Get("include").([]any)),
+		helper.ExpandStringList(d.Get("exclude").([]any)),
+	)
+	if err != nil {
+		return err
+	}
+
+	oldFiles := d.Get("files").(map[string]any)
+
+	changed := len(oldFiles) != len(localFiles)
+	for relPath, hash := range localFiles {
+		if oldHash, ok := oldFiles[relPath]; !ok || !strings.EqualFold(oldHash.(string), hash) {
+			changed = true
+			break
+		}
+	}
+
+	if changed {
+		tflog.Debug(ctx, "detected changes in the source directory")
+		return d.SetNew("files", localFiles)
+	}
+
+	return nil
+}
+
+// syncDirectory uploads the local files that differ from the given
+// previously synced files, or all of them when reuploadAll is set,
+// and deletes the objects of removed files.
+func syncDirectory(
+	ctx context.Context, d *schema.ResourceData, meta any, oldFiles map[string]string, reuploadAll bool,
+) diag.Diagnostics {
+	tflog.Debug(ctx, "entered 'syncDirectory' function")
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	sourceDir := d.Get("source_dir").(string)
+
+	localFiles, err := listLocalFiles(
+		sourceDir,
+		helper.ExpandStringList(d.Get("include").([]any)),
+		helper.ExpandStringList(d.Get("exclude").([]any)),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3client, teardownKeysCleanUp, diags := getS3Client(ctx, d, meta, "read_write")
+	if diags != nil {
+		return diags
+	}
+
+	if teardownKeysCleanUp != nil {
+		defer teardownKeysCleanUp()
+	}
+
+	uploader := s3manager.NewUploader(s3client)
+
+	contentTypes := make(map[string]string)
+	for ext, contentType := range d.Get("content_types").(map[string]any) {
+		contentTypes[ext] = contentType.(string)
+	}
+
+	for relPath, hash := range localFiles {
+		if oldHash, ok := oldFiles[relPath]; ok && !reuploadAll && strings.EqualFold(oldHash, hash) {
+			continue
+		}
+
+		key := buildObjectKey(prefix, relPath)
+		tflog.Debug(ctx, fmt.Sprintf("uploading file %s to %s", relPath, key))
+
+		if err := uploadFile(ctx, d, uploader, filepath.Join(sourceDir, relPath), key, contentTypes); err != nil {
+			return diag.Errorf("failed to upload %s to bucket %s: %s", relPath, bucket, err)
+		}
+	}
+
+	removedKeys := make([]string, 0)
+	for relPath := range oldFiles {
+		if _, ok := localFiles[relPath]; !ok {
+			removedKeys = append(removedKeys, buildObjectKey(prefix, relPath))
+		}
+	}
+
+	if err := deleteObjects(ctx, s3client, bucket, removedKeys); err != nil {
+		return diag.Errorf("failed to delete removed objects in bucket %s: %s", bucket, err)
+	}
+
+	d.Set("files", localFiles)
+
+	return nil
+}
+
+func uploadFile(
+	ctx context.Context,
+	d *schema.ResourceData,
+	uploader *s3manager.Uploader,
+	filePath, key string,
+	contentTypes map[string]string,
+) error {
+	contentType, err := detectContentType(filePath, contentTypes)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	putInput := &s3.PutObjectInput{
+		Bucket:      aws.String(d.Get("bucket").(string)),
+		Key:         aws.String(key),
+		Body:        file,
+		ContentType: aws.String(contentType),
+		ACL:         s3types.ObjectCannedACL(d.Get("acl").(string)),
+	}
+
+	if cacheControl := d.Get("cache_control").(string); cacheControl != "" {
+		putInput.CacheControl = aws.String(cacheControl)
+	}
+
+	_, err = uploader.Upload(ctx, putInput)
+	return err
+}
+
+func listRemoteETags(
+	ctx context.Context, client *s3.Client, bucket, prefix string,
+) (map[string]string, error) {
+	tflog.Debug(ctx, fmt.Sprintf("listing objects with prefix '%s' in bucket '%s'", prefix, bucket))
+
+	paginator := s3.NewListObjectsV2Paginator(client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	})
+
+	result := make(map[string]string)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range page.Contents {
+			result[aws.ToString(object.Key)] = strings.Trim(aws.ToString(object.ETag), `"`)
+		}
+	}
+
+	return result, nil
+}
+
+func deleteObjects(ctx context.Context, client *s3.Client, bucket string, keys []string) error {
+	for start := 0; start < len(keys); start += deleteObjectsBatchSize {
+		end := min(start+deleteObjectsBatchSize, len(keys))
+
+		objects := make([]s3types.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			objects = append(objects, s3types.ObjectIdentifier{Key: aws.String(key)})
+		}
+
+		tflog.Debug(ctx, "deleting objects", map[string]any{"keys": keys[start:end]})
+		if _, err := client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3types.Delete{Objects: objects},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getS3Client(
+	ctx context.Context, d *schema.ResourceData, meta any, permission string,
+) (*s3.Client, func(), diag.Diagnostics) {
+	cluster := d.Get("cluster").(string)
+	bucket := d.Get("bucket").(string)
+
+	objKeys, diags, teardownKeysCleanUp := obj.GetObjKeys(ctx, d, meta.(*helper.ProviderMeta), bucket, cluster, permission)
+	if diags != nil {
+		return nil, nil, diags
+	}
+
+	s3client, err := helper.S3ConnectionFromData(ctx, d, meta, objKeys.AccessKey, objKeys.SecretKey)
+	if err != nil {
+		if teardownKeysCleanUp != nil {
+			teardownKeysCleanUp()
+		}
+		return nil, nil, diag.FromErr(err)
+	}
+
+	return s3client, teardownKeysCleanUp, nil
+}
+
+func buildDirectoryID(d *schema.ResourceData) string {
+	return fmt.Sprintf("%s/%s", d.Get("bucket").(string), d.Get("prefix").(string))
+}
@@ -0,0 +1,131 @@
+//go:build integration
+
+package objdirectory_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/objdirectory/tmpl"
+)
+
+var testCluster string
+
+func init() {
+	cluster, err := acceptance.GetRandomOBJCluster()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testCluster = cluster
+}
+
+func writeTestFile(t *testing.T, dir, name, content string) {
+	filePath := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o700); err != nil {
+		t.Fatalf("failed to create directory: %s", err)
+	}
+
+	if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write test file: %s", err)
+	}
+}
+
+func TestAccResourceDirectory_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_object_storage_directory.foobar"
+	sourceDir := t.TempDir()
+
+	writeTestFile(t, sourceDir, "index.html", "<html>index</html>")
+	writeTestFile(t, sourceDir, "assets/main.css", "body {}")
+	writeTestFile(t, sourceDir, "assets/build.tmp", "ignored")
+
+	acceptance.RunTestRetry(t, 6, func(tRetry *acceptance.TRetry) {
+		bucketName := acctest.RandomWithPrefix("tf-test")
+		keyName := acctest.RandomWithPrefix("tf_test")
+
+		resource.Test(tRetry, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.Basic(t, bucketName, testCluster, keyName, sourceDir),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "files.%", "2"),
+						resource.TestCheckResourceAttrSet(resName, "files.index.html"),
+						resource.TestCheckResourceAttrSet(resName, "files.assets/main.css"),
+						checkObjectExists(resName, "site/index.html", "text/html; charset=utf-8"),
+						checkObjectExists(resName, "site/assets/main.css", "text/css; charset=utf-8"),
+					),
+				},
+				{
+					PreConfig: func() {
+						writeTestFile(t, sourceDir, "index.html", "<html>updated</html>")
+						writeTestFile(t, sourceDir, "about.html", "<html>about</html>")
+
+						if err := os.Remove(filepath.Join(sourceDir, "assets", "main.css")); err != nil {
+							t.Fatalf("failed to remove test file: %s", err)
+						}
+					},
+					Config: tmpl.Basic(t, bucketName, testCluster, keyName, sourceDir),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "files.%", "2"),
+						resource.TestCheckResourceAttrSet(resName, "files.about.html"),
+						resource.TestCheckNoResourceAttr(resName, "files.assets/main.css"),
+						checkObjectExists(resName, "site/about.html", "text/html; charset=utf-8"),
+					),
+				},
+				{
+					Config:   tmpl.Basic(t, bucketName, testCluster, keyName, sourceDir),
+					PlanOnly: true,
+				},
+			},
+		})
+	})
+}
+
+func checkObjectExists(resourceName, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		s3client, err := helper.S3Connection(
+			context.Background(),
+			rs.Primary.Attributes["endpoint"],
+			rs.Primary.Attributes["access_key"],
+			rs.Primary.Attributes["secret_key"],
+		)
+		if err != nil {
+			return err
+		}
+
+		output, err := s3client.HeadObject(context.Background(), &s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get object %s: %s", key, err)
+		}
+
+		if aws.ToString(output.ContentType) != contentType {
+			return fmt.Errorf("expected content type %s for %s, got %s", contentType, key, aws.ToString(output.ContentType))
+		}
+
+		return nil
+	}
+}
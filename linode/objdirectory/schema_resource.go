@@ -0,0 +1,92 @@
+package objdirectory
+
+import (
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+var resourceSchema = map[string]*schema.Schema{
+	"bucket": {
+		Type:        schema.TypeString,
+		Description: "The target bucket to sync the directory into.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	"cluster": {
+		Type:        schema.TypeString,
+		Description: "The target cluster that the bucket is in.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	"prefix": {
+		Type:        schema.TypeString,
+		Description: "The key prefix to upload the files of the directory under.",
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"source_dir": {
+		Type:        schema.TypeString,
+		Description: "The path of the local directory to sync into the bucket.",
+		Required:    true,
+	},
+	"include": {
+		Type: schema.TypeList,
+		Description: "Glob patterns of the files to sync, relative to source_dir. " +
+			"All files are synced if not specified.",
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	},
+	"exclude": {
+		Type:        schema.TypeList,
+		Description: "Glob patterns of the files to exclude from syncing, relative to source_dir.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"acl": {
+		Type:             schema.TypeString,
+		Description:      "The ACL config given to the uploaded objects.",
+		Default:          s3types.ObjectCannedACLPrivate,
+		ValidateDiagFunc: helper.SDKv2ObjectCannedACLValidator,
+		Optional:         true,
+	},
+	"cache_control": {
+		Type:        schema.TypeString,
+		Description: "The cache_control configuration of the uploaded objects.",
+		Optional:    true,
+	},
+	"content_types": {
+		Type: schema.TypeMap,
+		Description: "A map of file extensions to MIME types, overriding the content type " +
+			"detected for the uploaded objects.",
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	},
+	"secret_key": {
+		Type: schema.TypeString,
+		Description: "The S3 secret key with access to the target bucket. " +
+			"If not specified with the resource, the value will be read from provider-level obj_secret_key, " +
+			"or, generated implicitly at apply-time if obj_use_temp_keys in provider configuration is set.",
+		Optional:  true,
+		Sensitive: true,
+	},
+	"access_key": {
+		Type: schema.TypeString,
+		Description: "The S3 access key with access to the target bucket. " +
+			"If not specified with the resource, the value will be read from provider-level obj_access_key, " +
+			"or, generated implicitly at apply-time if obj_use_temp_keys in provider configuration is set.",
+		Optional: true,
+	},
+	"endpoint": {
+		Type:        schema.TypeString,
+		Description: "The endpoint for the bucket used for s3 connections.",
+		Optional:    true,
+		Computed:    true,
+	},
+	"files": {
+		Type:        schema.TypeMap,
+		Description: "A map of the synced file paths, relative to source_dir, to their md5 hashes.",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+}
@@ -0,0 +1,27 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	objbucket "github.com/linode/terraform-provider-linode/v2/linode/objbucket/tmpl"
+	objkey "github.com/linode/terraform-provider-linode/v2/linode/objkey/tmpl"
+)
+
+type TemplateData struct {
+	Bucket  objbucket.TemplateData
+	Key     objkey.TemplateData
+	Cluster string
+
+	SourceDir string
+}
+
+func Basic(t *testing.T, bucketName, cluster, keyName, sourceDir string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_directory_basic", TemplateData{
+			Bucket:    objbucket.TemplateData{Label: bucketName, Cluster: cluster},
+			Key:       objkey.TemplateData{Label: keyName},
+			Cluster:   cluster,
+			SourceDir: sourceDir,
+		})
+}
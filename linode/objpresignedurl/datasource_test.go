@@ -0,0 +1,87 @@
+//go:build integration
+
+package objpresignedurl_test
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/objpresignedurl/tmpl"
+)
+
+var testCluster string
+
+func init() {
+	cluster, err := acceptance.GetRandomOBJCluster()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testCluster = cluster
+}
+
+func TestAccDataSourcePresignedURL_basic(t *testing.T) {
+	t.Parallel()
+
+	getDataSourceName := "data.linode_object_storage_presigned_url.get"
+	putDataSourceName := "data.linode_object_storage_presigned_url.put"
+	content := "testing123"
+
+	acceptance.RunTestRetry(t, 6, func(tRetry *acceptance.TRetry) {
+		bucketName := acctest.RandomWithPrefix("tf-test")
+		keyName := acctest.RandomWithPrefix("tf_test")
+
+		resource.Test(tRetry, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.DataBasic(t, bucketName, testCluster, keyName, content),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(getDataSourceName, "method", "GET"),
+						resource.TestCheckResourceAttr(getDataSourceName, "expiration", "300"),
+						resource.TestCheckResourceAttrSet(getDataSourceName, "url"),
+						resource.TestCheckResourceAttrSet(getDataSourceName, "expires_at"),
+						resource.TestCheckResourceAttr(putDataSourceName, "method", "PUT"),
+						resource.TestCheckResourceAttr(putDataSourceName, "expiration", "900"),
+						checkPresignedURLBody(getDataSourceName, content),
+					),
+				},
+			},
+		})
+	})
+}
+
+func checkPresignedURLBody(name, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("data source not found: %s", name)
+		}
+
+		resp, err := http.Get(rs.Primary.Attributes["url"])
+		if err != nil {
+			return fmt.Errorf("failed to request presigned URL: %s", err)
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %s", err)
+		}
+
+		if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), expected) {
+			return fmt.Errorf("unexpected response from presigned URL: %d %s", resp.StatusCode, body)
+		}
+
+		return nil
+	}
+}
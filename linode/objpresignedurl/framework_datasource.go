@@ -0,0 +1,154 @@
+package objpresignedurl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/obj"
+)
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{
+		BaseDataSource: helper.NewBaseDataSource(
+			helper.BaseDataSourceConfig{
+				Name:   "linode_object_storage_presigned_url",
+				Schema: &frameworkDataSourceSchema,
+			},
+		),
+	}
+}
+
+type DataSource struct {
+	helper.BaseDataSource
+}
+
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read data.linode_object_storage_presigned_url")
+
+	var data DataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Method.IsNull() {
+		data.Method = types.StringValue(http.MethodGet)
+	}
+
+	if data.Expiration.IsNull() {
+		data.Expiration = types.Int64Value(defaultExpirationSeconds)
+	}
+
+	bucket := data.Bucket.ValueString()
+	cluster := data.Cluster.ValueString()
+	key := data.Key.ValueString()
+	method := data.Method.ValueString()
+
+	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
+		"bucket":     bucket,
+		"cluster":    cluster,
+		"object_key": key,
+		"method":     method,
+	})
+
+	permission := "read_only"
+	if method == http.MethodPut {
+		permission = "read_write"
+	}
+
+	s3client, endpoint, diags, teardownKeysCleanUp := obj.FrameworkS3Connection(
+		ctx,
+		d.Meta,
+		obj.ObjectKeys{
+			AccessKey: data.AccessKey.ValueString(),
+			SecretKey: data.SecretKey.ValueString(),
+		},
+		data.Endpoint.ValueString(),
+		bucket,
+		cluster,
+		permission,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if teardownKeysCleanUp != nil {
+		if !data.AllowTempKeys.ValueBool() {
+			teardownKeysCleanUp()
+			resp.Diagnostics.AddError(
+				"Presigned URL would be signed with temporary keys",
+				"Temporary object storage keys are deleted once the provider exits, after which "+
+					"the presigned URL is no longer valid. Specify access_key and secret_key or "+
+					"configure obj_access_key and obj_secret_key in the provider configuration "+
+					"to generate long-lived URLs, or set allow_temp_keys to accept short-lived URLs.",
+			)
+			return
+		}
+
+		// The temporary key is intentionally not released, which pins it in the
+		// broker until the provider exits rather than until it is rotated.
+	}
+
+	expiration := time.Duration(data.Expiration.ValueInt64()) * time.Second
+	expiresAt := time.Now().Add(expiration)
+	presignClient := s3.NewPresignClient(s3client, s3.WithPresignExpires(expiration))
+
+	tflog.Debug(ctx, "presigning object request", map[string]any{
+		"expiration": expiration.String(),
+	})
+
+	url, err := presignURL(ctx, presignClient, method, bucket, key)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to presign %s URL for object %s in bucket %s", method, key, bucket),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ParsePresignedURL(url, endpoint, expiresAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func presignURL(
+	ctx context.Context,
+	presignClient *s3.PresignClient,
+	method, bucket, key string,
+) (string, error) {
+	var request *v4.PresignedHTTPRequest
+	var err error
+
+	if method == http.MethodPut {
+		request, err = presignClient.PresignPutObject(ctx, &s3.PutObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+	} else {
+		request, err = presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return request.URL, nil
+}
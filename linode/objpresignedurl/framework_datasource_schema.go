@@ -0,0 +1,87 @@
+package objpresignedurl
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	defaultExpirationSeconds = 900
+
+	// maxExpirationSeconds is the maximum lifetime of a presigned URL (7 days).
+	maxExpirationSeconds = 604800
+)
+
+var frameworkDataSourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the object in the format of <bucket>/<key>.",
+			Computed:    true,
+		},
+		"bucket": schema.StringAttribute{
+			Description: "The bucket the object is in.",
+			Required:    true,
+		},
+		"cluster": schema.StringAttribute{
+			Description: "The cluster that the bucket is in.",
+			Required:    true,
+		},
+		"key": schema.StringAttribute{
+			Description: "The name of the object.",
+			Required:    true,
+		},
+		"method": schema.StringAttribute{
+			Description: "The HTTP method the URL is presigned for (GET, PUT). Defaults to GET.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(http.MethodGet, http.MethodPut),
+			},
+		},
+		"expiration": schema.Int64Attribute{
+			Description: "The number of seconds the URL is valid for. Defaults to 900.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, maxExpirationSeconds),
+			},
+		},
+		"access_key": schema.StringAttribute{
+			Description: "The S3 access key with access to the target bucket. " +
+				"If not specified, the value will be read from provider-level obj_access_key, " +
+				"or, generated implicitly if obj_use_temp_keys in provider configuration is set.",
+			Optional: true,
+		},
+		"secret_key": schema.StringAttribute{
+			Description: "The S3 secret key with access to the target bucket. " +
+				"If not specified, the value will be read from provider-level obj_secret_key, " +
+				"or, generated implicitly if obj_use_temp_keys in provider configuration is set.",
+			Optional:  true,
+			Sensitive: true,
+		},
+		"allow_temp_keys": schema.BoolAttribute{
+			Description: "Whether the URL may be signed with temporary keys generated through " +
+				"obj_use_temp_keys. The key is kept until the provider exits and deleted afterwards, so the URL " +
+				"stops working at the end of the Terraform run. Defaults to false.",
+			Optional: true,
+		},
+		"endpoint": schema.StringAttribute{
+			Description: "The endpoint for the bucket used for s3 connections.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"url": schema.StringAttribute{
+			Description: "The presigned URL.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"expires_at": schema.StringAttribute{
+			Description: "When the presigned URL expires.",
+			Computed:    true,
+		},
+	},
+}
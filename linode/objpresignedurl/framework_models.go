@@ -0,0 +1,30 @@
+package objpresignedurl
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Bucket        types.String `tfsdk:"bucket"`
+	Cluster       types.String `tfsdk:"cluster"`
+	Key           types.String `tfsdk:"key"`
+	Method        types.String `tfsdk:"method"`
+	Expiration    types.Int64  `tfsdk:"expiration"`
+	AccessKey     types.String `tfsdk:"access_key"`
+	SecretKey     types.String `tfsdk:"secret_key"`
+	AllowTempKeys types.Bool   `tfsdk:"allow_temp_keys"`
+	Endpoint      types.String `tfsdk:"endpoint"`
+	URL           types.String `tfsdk:"url"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+}
+
+func (data *DataSourceModel) ParsePresignedURL(url, endpoint string, expiresAt time.Time) {
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.Bucket.ValueString(), data.Key.ValueString()))
+	data.URL = types.StringValue(url)
+	data.Endpoint = types.StringValue(endpoint)
+	data.ExpiresAt = types.StringValue(expiresAt.UTC().Format(time.RFC3339))
+}
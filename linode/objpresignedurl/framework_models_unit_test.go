@@ -0,0 +1,69 @@
+//go:build unit
+
+package objpresignedurl
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePresignedURL(t *testing.T) {
+	data := DataSourceModel{
+		Bucket: types.StringValue("my-bucket"),
+		Key:    types.StringValue("uploads/artifact.zip"),
+	}
+
+	expiresAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	data.ParsePresignedURL("https://example.com/presigned", "us-east-1.linodeobjects.com", expiresAt)
+
+	assert.Equal(t, "my-bucket/uploads/artifact.zip", data.ID.ValueString())
+	assert.Equal(t, "https://example.com/presigned", data.URL.ValueString())
+	assert.Equal(t, "us-east-1.linodeobjects.com", data.Endpoint.ValueString())
+	assert.Equal(t, "2024-01-02T03:04:05Z", data.ExpiresAt.ValueString())
+}
+
+func TestPresignURL(t *testing.T) {
+	ctx := context.Background()
+
+	s3client, err := helper.S3Connection(ctx, "us-east-1.linodeobjects.com", "access", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	presignClient := s3.NewPresignClient(s3client, s3.WithPresignExpires(time.Minute))
+
+	getURL, err := presignURL(ctx, presignClient, http.MethodGet, "my-bucket", "file.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsedGetURL, err := url.Parse(getURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "my-bucket.us-east-1.linodeobjects.com", parsedGetURL.Host)
+	assert.Equal(t, "/file.txt", parsedGetURL.Path)
+	assert.Equal(t, "60", parsedGetURL.Query().Get("X-Amz-Expires"))
+
+	putURL, err := presignURL(ctx, presignClient, http.MethodPut, "my-bucket", "file.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsedPutURL, err := url.Parse(putURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "/file.txt", parsedPutURL.Path)
+	assert.NotEqual(t, parsedGetURL.Query().Get("X-Amz-Signature"), parsedPutURL.Query().Get("X-Amz-Signature"))
+}
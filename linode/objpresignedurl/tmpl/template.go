@@ -0,0 +1,26 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	objbucket "github.com/linode/terraform-provider-linode/v2/linode/objbucket/tmpl"
+	objkey "github.com/linode/terraform-provider-linode/v2/linode/objkey/tmpl"
+)
+
+type TemplateData struct {
+	Bucket  objbucket.TemplateData
+	Key     objkey.TemplateData
+	Cluster string
+	Content string
+}
+
+func DataBasic(t *testing.T, bucketName, cluster, keyName, content string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_presigned_url_data_basic", TemplateData{
+			Bucket:  objbucket.TemplateData{Label: bucketName, Cluster: cluster},
+			Key:     objkey.TemplateData{Label: keyName},
+			Cluster: cluster,
+			Content: content,
+		})
+}
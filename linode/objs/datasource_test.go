@@ -0,0 +1,55 @@
+//go:build integration
+
+package objs_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/objs/tmpl"
+)
+
+var testCluster string
+
+func init() {
+	cluster, err := acceptance.GetRandomOBJCluster()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testCluster = cluster
+}
+
+func TestAccDataSourceObjects_basic(t *testing.T) {
+	t.Parallel()
+
+	dataSourceName := "data.linode_object_storage_objects.foobar"
+
+	acceptance.RunTestRetry(t, 6, func(tRetry *acceptance.TRetry) {
+		bucketName := acctest.RandomWithPrefix("tf-test")
+		keyName := acctest.RandomWithPrefix("tf_test")
+
+		resource.Test(tRetry, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.DataBasic(t, bucketName, testCluster, keyName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(dataSourceName, "keys.#", "1"),
+						resource.TestCheckResourceAttr(dataSourceName, "keys.0", "site/index.html"),
+						resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.#", "1"),
+						resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.0", "site/assets/"),
+						resource.TestCheckResourceAttr(dataSourceName, "objects.#", "1"),
+						resource.TestCheckResourceAttr(dataSourceName, "objects.0.key", "site/index.html"),
+						resource.TestCheckResourceAttr(dataSourceName, "objects.0.size", "5"),
+						resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.etag"),
+					),
+				},
+			},
+		})
+	})
+}
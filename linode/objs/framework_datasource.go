@@ -0,0 +1,129 @@
+package objs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/obj"
+)
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{
+		BaseDataSource: helper.NewBaseDataSource(
+			helper.BaseDataSourceConfig{
+				Name:   "linode_object_storage_objects",
+				Schema: &frameworkDataSourceSchema,
+			},
+		),
+	}
+}
+
+type DataSource struct {
+	helper.BaseDataSource
+}
+
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read data.linode_object_storage_objects")
+
+	var data DataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := data.Bucket.ValueString()
+	cluster := data.Cluster.ValueString()
+
+	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
+		"bucket":  bucket,
+		"cluster": cluster,
+		"prefix":  data.Prefix.ValueString(),
+	})
+
+	s3client, endpoint, diags, teardownKeysCleanUp := obj.FrameworkS3Connection(
+		ctx,
+		d.Meta,
+		obj.ObjectKeys{
+			AccessKey: data.AccessKey.ValueString(),
+			SecretKey: data.SecretKey.ValueString(),
+		},
+		data.Endpoint.ValueString(),
+		bucket,
+		cluster,
+		"read_only",
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if teardownKeysCleanUp != nil {
+		defer teardownKeysCleanUp()
+	}
+
+	listInput := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if !data.Prefix.IsNull() {
+		listInput.Prefix = data.Prefix.ValueStringPointer()
+	}
+
+	if !data.Delimiter.IsNull() {
+		listInput.Delimiter = data.Delimiter.ValueStringPointer()
+	}
+
+	maxKeys := -1
+	if !data.MaxKeys.IsNull() {
+		maxKeys = helper.FrameworkSafeInt64ToInt(data.MaxKeys.ValueInt64(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "listing objects", map[string]any{"ListObjectsV2Input": listInput})
+
+	var objects []s3types.Object
+	var commonPrefixes []s3types.CommonPrefix
+
+	paginator := s3.NewListObjectsV2Paginator(s3client, listInput)
+	for paginator.HasMorePages() && (maxKeys < 0 || len(objects)+len(commonPrefixes) < maxKeys) {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to list objects in bucket %s", bucket),
+				err.Error(),
+			)
+			return
+		}
+
+		objects = append(objects, page.Contents...)
+		commonPrefixes = append(commonPrefixes, page.CommonPrefixes...)
+	}
+
+	if maxKeys >= 0 {
+		objects = objects[:min(len(objects), maxKeys)]
+		commonPrefixes = commonPrefixes[:min(len(commonPrefixes), maxKeys-len(objects))]
+	}
+
+	resp.Diagnostics.Append(data.ParseObjects(ctx, objects, commonPrefixes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Endpoint = types.StringValue(endpoint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,98 @@
+package objs
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var frameworkDataSourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of this listing.",
+			Computed:    true,
+		},
+		"bucket": schema.StringAttribute{
+			Description: "The bucket to list the objects of.",
+			Required:    true,
+		},
+		"cluster": schema.StringAttribute{
+			Description: "The cluster that the bucket is in.",
+			Required:    true,
+		},
+		"prefix": schema.StringAttribute{
+			Description: "Limits the listing to keys that begin with the given prefix.",
+			Optional:    true,
+		},
+		"delimiter": schema.StringAttribute{
+			Description: "A character used to group keys. Keys containing the delimiter after the prefix " +
+				"are grouped into common_prefixes.",
+			Optional: true,
+		},
+		"max_keys": schema.Int64Attribute{
+			Description: "The maximum number of keys to list.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"access_key": schema.StringAttribute{
+			Description: "The S3 access key with access to the target bucket. " +
+				"If not specified, the value will be read from provider-level obj_access_key, " +
+				"or, generated implicitly if obj_use_temp_keys in provider configuration is set.",
+			Optional: true,
+		},
+		"secret_key": schema.StringAttribute{
+			Description: "The S3 secret key with access to the target bucket. " +
+				"If not specified, the value will be read from provider-level obj_secret_key, " +
+				"or, generated implicitly if obj_use_temp_keys in provider configuration is set.",
+			Optional:  true,
+			Sensitive: true,
+		},
+		"endpoint": schema.StringAttribute{
+			Description: "The endpoint for the bucket used for s3 connections.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"keys": schema.ListAttribute{
+			Description: "The keys of the listed objects.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"common_prefixes": schema.ListAttribute{
+			Description: "The key prefixes grouped by the delimiter.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"objects": schema.ListNestedBlock{
+			Description: "The listed objects.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Description: "The name of the object.",
+						Computed:    true,
+					},
+					"etag": schema.StringAttribute{
+						Description: "The entity tag of the object.",
+						Computed:    true,
+					},
+					"size": schema.Int64Attribute{
+						Description: "The size of the object in bytes.",
+						Computed:    true,
+					},
+					"last_modified": schema.StringAttribute{
+						Description: "When this object was last modified.",
+						Computed:    true,
+					},
+					"storage_class": schema.StringAttribute{
+						Description: "The storage class of the object.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,89 @@
+package objs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DataSourceModel struct {
+	ID             types.String  `tfsdk:"id"`
+	Bucket         types.String  `tfsdk:"bucket"`
+	Cluster        types.String  `tfsdk:"cluster"`
+	Prefix         types.String  `tfsdk:"prefix"`
+	Delimiter      types.String  `tfsdk:"delimiter"`
+	MaxKeys        types.Int64   `tfsdk:"max_keys"`
+	AccessKey      types.String  `tfsdk:"access_key"`
+	SecretKey      types.String  `tfsdk:"secret_key"`
+	Endpoint       types.String  `tfsdk:"endpoint"`
+	Keys           types.List    `tfsdk:"keys"`
+	CommonPrefixes types.List    `tfsdk:"common_prefixes"`
+	Objects        []ObjectModel `tfsdk:"objects"`
+}
+
+type ObjectModel struct {
+	Key          types.String `tfsdk:"key"`
+	ETag         types.String `tfsdk:"etag"`
+	Size         types.Int64  `tfsdk:"size"`
+	LastModified types.String `tfsdk:"last_modified"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+func (data *DataSourceModel) ParseObjects(
+	ctx context.Context,
+	objects []s3types.Object,
+	commonPrefixes []s3types.CommonPrefix,
+) diag.Diagnostics {
+	data.ID = types.StringValue(fmt.Sprintf(
+		"%s/%s", data.Bucket.ValueString(), data.Prefix.ValueString(),
+	))
+
+	keys := make([]string, len(objects))
+	data.Objects = make([]ObjectModel, len(objects))
+
+	for i, object := range objects {
+		keys[i] = aws.ToString(object.Key)
+		data.Objects[i] = parseObject(object)
+	}
+
+	prefixes := make([]string, len(commonPrefixes))
+	for i, prefix := range commonPrefixes {
+		prefixes[i] = aws.ToString(prefix.Prefix)
+	}
+
+	keysList, diags := types.ListValueFrom(ctx, types.StringType, keys)
+	if diags.HasError() {
+		return diags
+	}
+	data.Keys = keysList
+
+	prefixesList, diags := types.ListValueFrom(ctx, types.StringType, prefixes)
+	if diags.HasError() {
+		return diags
+	}
+	data.CommonPrefixes = prefixesList
+
+	return nil
+}
+
+func parseObject(object s3types.Object) ObjectModel {
+	result := ObjectModel{
+		Key:          types.StringPointerValue(object.Key),
+		ETag:         types.StringValue(strings.Trim(aws.ToString(object.ETag), `"`)),
+		Size:         types.Int64PointerValue(object.Size),
+		LastModified: types.StringNull(),
+		StorageClass: types.StringValue(string(object.StorageClass)),
+	}
+
+	if object.LastModified != nil {
+		result.LastModified = types.StringValue(object.LastModified.Format(time.RFC3339))
+	}
+
+	return result
+}
@@ -0,0 +1,60 @@
+//go:build unit
+
+package objs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseObjects(t *testing.T) {
+	lastModified := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	data := DataSourceModel{
+		Bucket: types.StringValue("my-bucket"),
+		Prefix: types.StringValue("site/"),
+	}
+
+	diags := data.ParseObjects(
+		context.Background(),
+		[]s3types.Object{
+			{
+				Key:          aws.String("site/index.html"),
+				ETag:         aws.String(`"5eb63bbbe01eeed093cb22bb8f5acdc3"`),
+				Size:         aws.Int64(42),
+				LastModified: &lastModified,
+				StorageClass: s3types.ObjectStorageClassStandard,
+			},
+		},
+		[]s3types.CommonPrefix{
+			{Prefix: aws.String("site/assets/")},
+		},
+	)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, "my-bucket/site/", data.ID.ValueString())
+
+	expectedKeys := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("site/index.html"),
+	})
+	assert.True(t, expectedKeys.Equal(data.Keys))
+
+	expectedPrefixes := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("site/assets/"),
+	})
+	assert.True(t, expectedPrefixes.Equal(data.CommonPrefixes))
+
+	assert.Len(t, data.Objects, 1)
+	assert.Equal(t, "site/index.html", data.Objects[0].Key.ValueString())
+	assert.Equal(t, "5eb63bbbe01eeed093cb22bb8f5acdc3", data.Objects[0].ETag.ValueString())
+	assert.Equal(t, int64(42), data.Objects[0].Size.ValueInt64())
+	assert.Equal(t, "2024-01-02T03:04:05Z", data.Objects[0].LastModified.ValueString())
+	assert.Equal(t, "STANDARD", data.Objects[0].StorageClass.ValueString())
+}
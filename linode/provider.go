@@ -17,14 +17,15 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/domain"
 	"github.com/linode/terraform-provider-linode/v2/linode/domainrecord"
 	"github.com/linode/terraform-provider-linode/v2/linode/firewall"
+	"github.com/linode/terraform-provider-linode/v2/linode/firewallrules"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"github.com/linode/terraform-provider-linode/v2/linode/instance"
 	"github.com/linode/terraform-provider-linode/v2/linode/instanceconfig"
 	"github.com/linode/terraform-provider-linode/v2/linode/lke"
-	"github.com/linode/terraform-provider-linode/v2/linode/nbconfig"
-	"github.com/linode/terraform-provider-linode/v2/linode/nbnode"
 	"github.com/linode/terraform-provider-linode/v2/linode/obj"
 	"github.com/linode/terraform-provider-linode/v2/linode/objbucket"
+	"github.com/linode/terraform-provider-linode/v2/linode/objbucketpolicy"
+	"github.com/linode/terraform-provider-linode/v2/linode/objdirectory"
 	"github.com/linode/terraform-provider-linode/v2/linode/user"
 )
 
@@ -80,6 +81,13 @@ func Provider() *schema.Provider {
 				Description: "If true, Linode Instances will not be rebooted on config and interface changes.",
 			},
 
+			"skip_instance_firewall_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Skip checking whether a linode_instance resource is still attached " +
+					"to the firewall it was created with.",
+			},
+
 			"disable_internal_cache": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -128,6 +136,17 @@ func Provider() *schema.Provider {
 				Description: "If true, temporary object keys will be created implicitly at apply-time " +
 					"for the linode_object_storage_object and linode_object_sorage_bucket resource.",
 			},
+			"obj_endpoint_override": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The S3 endpoint used for object storage operations instead of the one computed " +
+					"from the cluster, e.g. a local S3-compatible server for testing.",
+			},
+			"obj_use_path_style": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, buckets are addressed in the request path instead of the endpoint hostname.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -136,20 +155,21 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"linode_database_access_controls": databaseaccesscontrols.Resource(),
-			"linode_database_mysql":           databasemysql.Resource(),
-			"linode_database_postgresql":      databasepostgresql.Resource(),
-			"linode_domain":                   domain.Resource(),
-			"linode_domain_record":            domainrecord.Resource(),
-			"linode_firewall":                 firewall.Resource(),
-			"linode_instance":                 instance.Resource(),
-			"linode_instance_config":          instanceconfig.Resource(),
-			"linode_lke_cluster":              lke.Resource(),
-			"linode_nodebalancer_node":        nbnode.Resource(),
-			"linode_nodebalancer_config":      nbconfig.Resource(),
-			"linode_object_storage_bucket":    objbucket.Resource(),
-			"linode_object_storage_object":    obj.Resource(),
-			"linode_user":                     user.Resource(),
+			"linode_database_access_controls":     databaseaccesscontrols.Resource(),
+			"linode_database_mysql":               databasemysql.Resource(),
+			"linode_database_postgresql":          databasepostgresql.Resource(),
+			"linode_domain":                       domain.Resource(),
+			"linode_domain_record":                domainrecord.Resource(),
+			"linode_firewall":                     firewall.Resource(),
+			"linode_firewall_rules":               firewallrules.Resource(),
+			"linode_instance":                     instance.Resource(),
+			"linode_instance_config":              instanceconfig.Resource(),
+			"linode_lke_cluster":                  lke.Resource(),
+			"linode_object_storage_bucket":        objbucket.Resource(),
+			"linode_object_storage_bucket_policy": objbucketpolicy.Resource(),
+			"linode_object_storage_directory":     objdirectory.Resource(),
+			"linode_object_storage_object":        obj.Resource(),
+			"linode_user":                         user.Resource(),
 		},
 	}
 
@@ -243,6 +263,20 @@ func handleDefault(config *helper.Config, d *schema.ResourceData) diag.Diagnosti
 		config.ObjSecretKey = os.Getenv("LINODE_OBJ_SECRET_KEY")
 	}
 
+	if v, ok := d.GetOk("obj_endpoint_override"); ok {
+		config.ObjEndpointOverride = v.(string)
+	} else {
+		config.ObjEndpointOverride = os.Getenv("LINODE_OBJ_ENDPOINT_OVERRIDE")
+	}
+
+	if d.GetRawConfig().GetAttr("obj_use_path_style").IsNull() {
+		usePathStyle, err := strconv.ParseBool(os.Getenv("LINODE_OBJ_USE_PATH_STYLE"))
+		if err != nil {
+			usePathStyle = false
+		}
+		config.ObjUsePathStyle = usePathStyle
+	}
+
 	return nil
 }
 
@@ -254,12 +288,15 @@ func providerConfigure(
 		SkipInstanceDeletePoll: d.Get("skip_instance_delete_poll").(bool),
 		SkipImplicitReboots:    d.Get("skip_implicit_reboots").(bool),
 
+		SkipInstanceFirewallCheck: d.Get("skip_instance_firewall_check").(bool),
+
 		DisableInternalCache: d.Get("disable_internal_cache").(bool),
 
 		MinRetryDelayMilliseconds: d.Get("min_retry_delay_ms").(int),
 		MaxRetryDelayMilliseconds: d.Get("max_retry_delay_ms").(int),
 
-		ObjUseTempKeys: d.Get("obj_use_temp_keys").(bool),
+		ObjUseTempKeys:  d.Get("obj_use_temp_keys").(bool),
+		ObjUsePathStyle: d.Get("obj_use_path_style").(bool),
 	}
 
 	handleDefault(config, d)
@@ -270,12 +307,29 @@ func providerConfigure(
 		return nil, diag.Errorf("failed to initialize client: %s", err)
 	}
 
-	// Ping the API for an empty response to verify the configuration works
-	if _, err := client.ListTypes(ctx, linodego.NewListOptions(100, "")); err != nil {
-		return nil, diag.Errorf("Error connecting to the Linode API: %s", err)
+	// Ping the API for an empty response to verify the configuration works.
+	// Object storage is served by the override alone, so the ping is skipped
+	// to allow running against a local S3-compatible server without a token.
+	if config.ObjEndpointOverride == "" {
+		if _, err := client.ListTypes(ctx, linodego.NewListOptions(100, "")); err != nil {
+			return nil, diag.Errorf("Error connecting to the Linode API: %s", err)
+		}
 	}
-	return &helper.ProviderMeta{
+	meta := &helper.ProviderMeta{
 		Client: *client,
 		Config: config,
-	}, nil
+	}
+
+	if config.ObjUseTempKeys {
+		if config.ObjEndpointOverride != "" {
+			return nil, diag.Errorf(
+				"obj_use_temp_keys can't be used with obj_endpoint_override " +
+					"since temporary keys are created through the Linode API",
+			)
+		}
+
+		meta.ObjTempKeys = helper.SharedObjTempKeyBroker(*client, config.AccessToken, config.APIURL, helper.ObjTempKeyTTL)
+	}
+
+	return meta, nil
 }
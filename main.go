@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/linode/terraform-provider-linode/v2/linode"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"github.com/linode/terraform-provider-linode/v2/version"
 )
 
@@ -47,6 +48,10 @@ func main() {
 		muxServer.ProviderServer,
 		serveOpts...,
 	)
+
+	// Delete the temporary object storage keys still in use when the server stopped
+	helper.ShutdownObjTempKeyBrokers(ctx)
+
 	if err != nil {
 		log.Fatal(err)
 	}